@@ -0,0 +1,26 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/locales/#show-locale
+
+data "zendesk_locale" "english" {
+  locale = "en-US"
+}
+
+data "zendesk_locale" "japanese" {
+  locale = "ja"
+}
+
+resource "zendesk_dynamic_content_item" "welcome-message" {
+  name              = "welcome_message"
+  default_locale_id = data.zendesk_locale.english.id
+
+  variant {
+    locale_id = data.zendesk_locale.english.id
+    content   = "Thank you for contacting us."
+    default   = true
+  }
+
+  variant {
+    locale_id = data.zendesk_locale.japanese.id
+    content   = "お問い合わせありがとうございます。"
+  }
+}
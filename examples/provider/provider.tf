@@ -2,7 +2,7 @@
 #   https://developer.zendesk.com/rest_api/docs/support/introduction#security-and-authentication
 #
 # NOTE:
-#   currently only token authentication is supported
+#   configure either email + API token, or an OAuth access token
 
 terraform {
   required_providers {
@@ -26,3 +26,10 @@ provider "zendesk" {
   # export ZENDESK_EMAIL="john.doe@example.com"
   # export ZENDESK_TOKEN="xxxxxxxxxx"
 }
+
+# OAuth access token authentication
+#
+# provider "zendesk" {
+#   account     = "example"
+#   oauth_token = "xxxxxxxxxx" # or export ZENDESK_OAUTH_TOKEN="xxxxxxxxxx"
+# }
@@ -0,0 +1 @@
+terraform import zendesk_custom_role.team-lead 1234567890
@@ -0,0 +1,25 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/
+
+resource "zendesk_custom_role" "team-lead" {
+  name        = "Team lead"
+  description = "Agents who lead a support team"
+
+  configuration {
+    ticket_access         = "within-groups"
+    ticket_editing        = true
+    ticket_merge          = true
+    ticket_tag_editing    = true
+    manage_business_rules = true
+    macro_access          = "manage-group"
+    view_access           = "manage-group"
+    report_access         = "readonly"
+  }
+}
+
+resource "zendesk_user" "lead" {
+  name           = "Jane Doe"
+  email          = "jane.doe@example.com"
+  role           = "agent"
+  custom_role_id = zendesk_custom_role.team-lead.id
+}
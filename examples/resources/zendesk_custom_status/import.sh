@@ -0,0 +1 @@
+terraform import zendesk_custom_status.waiting-on-vendor 1234567890
@@ -0,0 +1,24 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+
+resource "zendesk_custom_status" "waiting-on-vendor" {
+  status_category = "pending"
+  agent_label     = "Waiting on vendor"
+  end_user_label  = "Waiting on a partner"
+  description     = "Waiting for a reply from a vendor"
+}
+
+resource "zendesk_trigger" "vendor-escalation" {
+  title = "Move vendor escalations to Waiting on vendor"
+
+  all {
+    field    = "current_tags"
+    operator = "includes"
+    value    = "vendor_escalation"
+  }
+
+  action {
+    field = "custom_status_id"
+    value = zendesk_custom_status.waiting-on-vendor.id
+  }
+}
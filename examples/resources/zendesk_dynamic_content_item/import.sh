@@ -0,0 +1 @@
+terraform import zendesk_dynamic_content_item.welcome-message 1234567890
@@ -0,0 +1,37 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/ticket-management/dynamic_content/
+
+resource "zendesk_dynamic_content_item" "welcome-message" {
+  name              = "welcome_message"
+  default_locale_id = 1 # English
+
+  variant {
+    locale_id = 1
+    content   = "Thank you for contacting us."
+    default   = true
+  }
+
+  variant {
+    locale_id = 67 # Japanese
+    content   = "お問い合わせありがとうございます。"
+  }
+}
+
+resource "zendesk_trigger" "welcome" {
+  title = "Welcome new tickets"
+
+  all {
+    field    = "update_type"
+    operator = "is"
+    value    = "Create"
+  }
+
+  action {
+    field = "notification_user"
+    value = jsonencode([
+      "requester_id",
+      "Re: {{ticket.title}}",
+      zendesk_dynamic_content_item.welcome-message.placeholder
+    ])
+  }
+}
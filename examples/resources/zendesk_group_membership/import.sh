@@ -0,0 +1,5 @@
+# import by group_id:user_id
+terraform import zendesk_group_membership.agent-moderator 1234567890:9876543210
+
+# or by group membership ID
+terraform import zendesk_group_membership.agent-moderator 1122334455
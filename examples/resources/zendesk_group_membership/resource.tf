@@ -0,0 +1,8 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+
+resource "zendesk_group_membership" "agent-moderator" {
+  group_id = zendesk_group.moderator-group.id
+  user_id  = zendesk_user.agent.id
+  default  = true
+}
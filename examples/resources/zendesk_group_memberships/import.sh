@@ -0,0 +1,2 @@
+# import by group ID
+terraform import zendesk_group_memberships.developers 1234567890
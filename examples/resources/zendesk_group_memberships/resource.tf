@@ -0,0 +1,10 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+
+# Agents not listed in user_ids are removed from the group.
+resource "zendesk_group_memberships" "developers" {
+  group_id = zendesk_group.developer-group.id
+  user_ids = [
+    zendesk_user.agent.id,
+  ]
+}
@@ -0,0 +1,5 @@
+# import by brand_id:article_id
+terraform import zendesk_help_center_article.download-invoice 1234567890:9876543210
+
+# or by article ID for the default brand
+terraform import zendesk_help_center_article.download-invoice 9876543210
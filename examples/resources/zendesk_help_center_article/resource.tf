@@ -0,0 +1,20 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+
+resource "zendesk_help_center_article" "download-invoice" {
+  brand_id            = zendesk_help_center_section.invoices.brand_id
+  section_id          = zendesk_help_center_section.invoices.id
+  locale              = "en-us"
+  title               = "How to download an invoice"
+  body_file           = "${path.module}/articles/download-invoice.html"
+  permission_group_id = 1234567890
+  label_names         = ["invoice", "billing"]
+  promoted            = true
+
+  translation {
+    locale = "ja"
+    title  = "請求書のダウンロード方法"
+    body   = file("${path.module}/articles/download-invoice.ja.html")
+    draft  = true
+  }
+}
@@ -0,0 +1,5 @@
+# import by brand_id:category_id
+terraform import zendesk_help_center_category.billing 1234567890:9876543210
+
+# or by category ID for the default brand
+terraform import zendesk_help_center_category.billing 9876543210
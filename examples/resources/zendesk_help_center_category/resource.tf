@@ -0,0 +1,15 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/
+
+resource "zendesk_help_center_category" "billing" {
+  brand_id    = zendesk_brand.support.id
+  locale      = "en-us"
+  name        = "Billing"
+  description = "Invoices, payments and refunds"
+
+  translation {
+    locale = "ja"
+    title  = "請求"
+    body   = "請求書、支払い、返金について"
+  }
+}
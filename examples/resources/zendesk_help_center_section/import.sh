@@ -0,0 +1,5 @@
+# import by brand_id:section_id
+terraform import zendesk_help_center_section.invoices 1234567890:9876543210
+
+# or by section ID for the default brand
+terraform import zendesk_help_center_section.invoices 9876543210
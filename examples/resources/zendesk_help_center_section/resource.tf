@@ -0,0 +1,18 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/
+
+resource "zendesk_help_center_section" "invoices" {
+  brand_id    = zendesk_help_center_category.billing.brand_id
+  category_id = zendesk_help_center_category.billing.id
+  locale      = "en-us"
+  name        = "Invoices"
+}
+
+resource "zendesk_help_center_section" "invoice-disputes" {
+  brand_id          = zendesk_help_center_category.billing.brand_id
+  category_id       = zendesk_help_center_category.billing.id
+  parent_section_id = zendesk_help_center_section.invoices.id
+  locale            = "en-us"
+  name              = "Disputes"
+  description       = "Questioning a charge on your invoice"
+}
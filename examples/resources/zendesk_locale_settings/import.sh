@@ -0,0 +1 @@
+terraform import zendesk_locale_settings.account locale_settings
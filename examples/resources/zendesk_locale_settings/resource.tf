@@ -0,0 +1,7 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/locales/
+
+resource "zendesk_locale_settings" "account" {
+  default_locale = "en-US"
+  locales        = ["en-US", "ja", "de"]
+}
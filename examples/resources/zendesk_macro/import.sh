@@ -0,0 +1 @@
+terraform import zendesk_macro.close-and-thank 1234567890
@@ -0,0 +1,25 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/
+
+resource "zendesk_macro" "close-and-thank" {
+  title       = "Close and thank"
+  description = "Solve the ticket and thank the requester"
+
+  restriction {
+    type = "Group"
+    ids  = [zendesk_group.support.id]
+  }
+
+  action {
+    field = "status"
+    value = "solved"
+  }
+
+  action {
+    field = "comment_value_html"
+    value = jsonencode([
+      "channel:all",
+      "<p>Thank you for contacting us.</p>"
+    ])
+  }
+}
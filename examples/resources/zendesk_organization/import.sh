@@ -0,0 +1 @@
+terraform import zendesk_organization.rebel-alliance 1234567890
@@ -0,0 +1,16 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/
+
+resource "zendesk_organization" "rebel-alliance" {
+  name         = "Rebel Alliance"
+  domain_names = ["rebellion.example.com"]
+  external_id  = "crm-1234"
+  details      = "Yavin 4"
+  notes        = "Key account"
+  tags         = ["vip"]
+
+  organization_fields = {
+    account_tier = "gold"
+    seats        = "25"
+  }
+}
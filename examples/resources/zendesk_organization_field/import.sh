@@ -0,0 +1,5 @@
+# import by key
+terraform import zendesk_organization_field.account-manager account_manager
+
+# or by organization field ID
+terraform import zendesk_organization_field.account-manager 1234567890
@@ -0,0 +1,15 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/
+
+resource "zendesk_organization_field" "account-manager" {
+  key                      = "account_manager"
+  type                     = "lookup"
+  title                    = "Account manager"
+  relationship_target_type = "zen:user"
+}
+
+resource "zendesk_organization_field" "contract-start" {
+  key   = "contract_start"
+  type  = "date"
+  title = "Contract start"
+}
@@ -0,0 +1,5 @@
+# import by organization_id:user_id
+terraform import zendesk_organization_membership.customer-rebel-alliance 1234567890:9876543210
+
+# or by organization membership ID
+terraform import zendesk_organization_membership.customer-rebel-alliance 1122334455
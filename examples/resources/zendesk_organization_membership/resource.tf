@@ -0,0 +1,8 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/
+
+resource "zendesk_organization_membership" "customer-rebel-alliance" {
+  organization_id = zendesk_organization.rebel-alliance.id
+  user_id         = zendesk_user.customer.id
+  default         = true
+}
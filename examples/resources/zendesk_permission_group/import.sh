@@ -0,0 +1 @@
+terraform import zendesk_permission_group.documentation 1234567890
@@ -0,0 +1,8 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/
+
+resource "zendesk_permission_group" "documentation" {
+  name              = "Documentation team"
+  edit_group_ids    = [zendesk_group.support.id, zendesk_group.docs.id]
+  publish_group_ids = [zendesk_group.docs.id]
+}
@@ -0,0 +1 @@
+terraform import zendesk_schedule.business-hours 1234567890
@@ -0,0 +1,25 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/
+
+resource "zendesk_schedule" "business-hours" {
+  name      = "Business hours"
+  time_zone = "Tokyo"
+
+  interval {
+    day        = "monday"
+    start_time = "09:00"
+    end_time   = "12:00"
+  }
+
+  interval {
+    day        = "monday"
+    start_time = "13:00"
+    end_time   = "18:00"
+  }
+
+  interval {
+    day        = "tuesday"
+    start_time = "09:00"
+    end_time   = "18:00"
+  }
+}
@@ -0,0 +1,2 @@
+# import by schedule_id:holiday_id
+terraform import zendesk_schedule_holiday.new-year 1234567890:9876543210
@@ -0,0 +1,9 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#create-a-holiday
+
+resource "zendesk_schedule_holiday" "new-year" {
+  schedule_id = zendesk_schedule.business-hours.id
+  name        = "New Year"
+  start_date  = "2027-01-01"
+  end_date    = "2027-01-03"
+}
@@ -0,0 +1 @@
+terraform import zendesk_support_address.acme-billing 1234567890
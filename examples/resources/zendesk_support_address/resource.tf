@@ -0,0 +1,20 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+
+resource "zendesk_brand" "acme" {
+  name      = "Acme"
+  subdomain = "acme"
+}
+
+resource "zendesk_support_address" "acme-billing" {
+  email    = "billing@acme.example.com"
+  name     = "Acme Billing"
+  brand_id = zendesk_brand.acme.id
+}
+
+output "acme_billing_unverified_dns" {
+  value = [
+    for status in ["spf_status", "cname_status", "domain_verification_status"] :
+    status if zendesk_support_address.acme-billing[status] != "verified"
+  ]
+}
@@ -0,0 +1,5 @@
+# import by user ID
+terraform import zendesk_user.agent 1234567890
+
+# or by primary email address
+terraform import zendesk_user.agent john.doe@example.com
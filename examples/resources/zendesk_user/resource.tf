@@ -0,0 +1,16 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/users/users/
+
+resource "zendesk_user" "agent" {
+  name             = "John Doe"
+  email            = "john.doe@example.com"
+  role             = "agent"
+  default_group_id = zendesk_group.moderator-group.id
+  time_zone        = "Tokyo"
+  locale           = "ja"
+  tags             = ["tier2"]
+
+  user_fields = {
+    employee_id = "E1234"
+  }
+}
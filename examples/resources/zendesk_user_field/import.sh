@@ -0,0 +1,5 @@
+# import by key
+terraform import zendesk_user_field.support-tier support_tier
+
+# or by user field ID
+terraform import zendesk_user_field.support-tier 1234567890
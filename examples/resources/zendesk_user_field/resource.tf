@@ -0,0 +1,25 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/users/user_fields/
+
+resource "zendesk_user_field" "support-tier" {
+  key   = "support_tier"
+  type  = "dropdown"
+  title = "Support tier"
+
+  custom_field_option {
+    name  = "Gold"
+    value = "gold"
+  }
+
+  custom_field_option {
+    name  = "Silver"
+    value = "silver"
+  }
+}
+
+resource "zendesk_user_field" "employee-id" {
+  key                   = "employee_id"
+  type                  = "regexp"
+  title                 = "Employee ID"
+  regexp_for_validation = "^E[0-9]{6}$"
+}
@@ -0,0 +1 @@
+terraform import zendesk_user_segment.vip-customers 1234567890
@@ -0,0 +1,18 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/
+
+resource "zendesk_user_segment" "vip-customers" {
+  name             = "VIP customers"
+  user_type        = "signed_in_users"
+  organization_ids = [zendesk_organization.acme.id]
+  or_tags          = ["vip", "enterprise"]
+}
+
+resource "zendesk_help_center_article" "vip-support-line" {
+  section_id          = zendesk_help_center_section.support.id
+  locale              = "en-us"
+  title               = "Your dedicated support line"
+  body                = "<p>Call us any time at +1 555 0100.</p>"
+  permission_group_id = zendesk_permission_group.documentation.id
+  user_segment_id     = zendesk_user_segment.vip-customers.id
+}
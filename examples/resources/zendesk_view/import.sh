@@ -0,0 +1 @@
+terraform import zendesk_view.unassigned-tickets 1234567890
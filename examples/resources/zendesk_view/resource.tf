@@ -0,0 +1,30 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/business-rules/views/
+
+resource "zendesk_view" "unassigned-tickets" {
+  title       = "Unassigned tickets"
+  description = "Open tickets waiting for an agent"
+
+  all {
+    field    = "status"
+    operator = "less_than"
+    value    = "solved"
+  }
+
+  all {
+    field    = "assignee_id"
+    operator = "is"
+    value    = ""
+  }
+
+  columns     = ["subject", "requester", "created", zendesk_ticket_field.product.id]
+  group_by    = "group"
+  group_order = "asc"
+  sort_by     = "created"
+  sort_order  = "desc"
+
+  restriction {
+    type = "Group"
+    ids  = [zendesk_group.support.id]
+  }
+}
@@ -0,0 +1 @@
+terraform import zendesk_webhook.notify-backend 01GDXYD7ZTWYP542BA8MDDTE36
@@ -0,0 +1,51 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/webhooks/webhooks-api/webhooks/
+
+variable "backend_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "zendesk_webhook" "notify-backend" {
+  name          = "Notify backend"
+  endpoint      = "https://example.com/zendesk"
+  http_method   = "POST"
+  subscriptions = ["conditional_ticket_events"]
+
+  authentication {
+    type     = "basic_auth"
+    username = "zendesk"
+    password = var.backend_password
+  }
+
+  custom_headers = {
+    "X-Environment" = "production"
+  }
+
+  # Change to reset the signing secret
+  rotate_signing_secret = "2024-01"
+}
+
+# The signing secret is sensitive and can be passed to a secrets backend
+output "notify_backend_signing_secret" {
+  value     = zendesk_webhook.notify-backend.signing_secret
+  sensitive = true
+}
+
+resource "zendesk_trigger" "notify-backend-on-create" {
+  title = "Notify backend on ticket creation"
+
+  all {
+    field    = "update_type"
+    operator = "is"
+    value    = "Create"
+  }
+
+  action {
+    field = "notification_webhook"
+    value = jsonencode([
+      zendesk_webhook.notify-backend.id,
+      jsonencode({ ticket_id = "{{ticket.id}}" })
+    ])
+  }
+}
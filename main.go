@@ -9,10 +9,15 @@ import (
 // Generate provider document
 //go:generate go run -mod=mod github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
+var (
+	// version is injected at build time by goreleaser
+	version = "dev"
+)
+
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
-			return zendesk.Provider()
+			return zendesk.New(version)
 		},
 	})
 }
@@ -1,8 +1,79 @@
 package zendesk
 
-// Config is configuration struct for Zendesk credentials
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Config is configuration struct for Zendesk credentials and API client
 type Config struct {
 	Account string
 	Email   string
 	Token   string
+
+	OAuthToken string
+
+	APIURL             string
+	CABundleFile       string
+	InsecureSkipVerify bool
+	UserAgent          string
+
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	RequestsPerMinute int
+	FixedRateLimit    bool
+}
+
+// newHTTPClient builds the HTTP client shared by all resources of a provider instance
+func newHTTPClient(config Config) (*http.Client, error) {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.CABundleFile != "" || config.InsecureSkipVerify {
+		tlsConfig, err := newTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		base.TLSClientConfig = tlsConfig
+	}
+
+	// Transports wrapped later run first. User-Agent is set before logging
+	// so that the logs show the headers actually sent to Zendesk.
+	var transport http.RoundTripper = base
+	transport = newLoggingTransport(transport)
+	transport = newUserAgentTransport(transport, config.UserAgent)
+	transport = newRateLimitTransport(transport, config.RequestsPerMinute, !config.FixedRateLimit)
+	transport = newRetryTransport(transport, config.MaxRetries, config.MinBackoff, config.MaxBackoff)
+
+	return &http.Client{Transport: transport}, nil
+}
+
+func newTLSConfig(config Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if config.CABundleFile != "" {
+		pem, err := os.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA bundle %s: %v", config.CABundleFile, err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
 }
@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/locales/#show-locale
+func dataSourceZendeskLocale() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resolves a locale code to the numeric id used by other resources, e.g. the `locale_id` of dynamic content variants.",
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readLocaleDataSource(ctx, d, zd)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"locale": {
+				Description: "The code of the locale, e.g. `ja`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the locale.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readLocaleDataSource(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	code := d.Get("locale").(string)
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/locales/%s.json", url.PathEscape(code)))
+	if isNotFound(err) {
+		return diag.Errorf("unable to locate any locale with code: %s", code)
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	locale, err := decodeLocale(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", locale.ID))
+
+	err = d.Set("name", locale.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func decodeLocale(body []byte) (accountLocale, error) {
+	var result struct {
+		Locale accountLocale `json:"locale"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Locale, err
+}
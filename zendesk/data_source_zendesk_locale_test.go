@@ -0,0 +1,50 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestLocaleDataSourceRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := newIdentifiableGetterSetter()
+	if err := m.Set("locale", "ja"); err != nil {
+		t.Fatalf("Read locale returned an error. %v", err)
+	}
+
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/locales/ja.json")).
+		Return([]byte(`{"locale":{"id":67,"locale":"ja","name":"Japanese"}}`), nil)
+
+	diags := readLocaleDataSource(context.Background(), m, c)
+	if len(diags) != 0 {
+		t.Fatalf("Read locale returned an error. %v", diags)
+	}
+
+	if v := m.Id(); v != "67" {
+		t.Fatalf("Read locale did not set ID field. Expected 67, Got %v", v)
+	}
+	if v := m.Get("name"); v != "Japanese" {
+		t.Fatalf("Read locale did not set name field. Expected Japanese, Got %v", v)
+	}
+}
+
+func TestLocaleDataSourceReadNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := newIdentifiableGetterSetter()
+	if err := m.Set("locale", "xx"); err != nil {
+		t.Fatalf("Read locale returned an error. %v", err)
+	}
+
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/locales/xx.json")).Return(nil, newNotFoundError())
+
+	if diags := readLocaleDataSource(context.Background(), m, c); !diags.HasError() {
+		t.Fatalf("Read locale should fail for an unknown locale code")
+	}
+}
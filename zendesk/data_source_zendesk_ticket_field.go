@@ -140,7 +140,7 @@ func readTicketFieldDataSource(ctx context.Context, d identifiableGetterSetter,
 
 	ticketFields, _, err := zd.GetTicketFields(context.Background())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var found *zendesk.TicketField
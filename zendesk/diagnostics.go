@@ -0,0 +1,111 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// Attributes whose name in API error details differs from the schema
+var errorDetailAttributes = map[string]string{
+	"actions":              "action",
+	"custom_field_options": "custom_field_option",
+}
+
+// Error detail keys which don't refer to an attribute
+var errorDetailGeneral = map[string]bool{
+	"base": true,
+}
+
+// apiErrorBody is the body of Zendesk error responses
+// https://developer.zendesk.com/api-reference/introduction/requests/#errors
+type apiErrorBody struct {
+	Error       json.RawMessage             `json:"error"`
+	Description string                      `json:"description"`
+	Details     map[string][]apiErrorDetail `json:"details"`
+}
+
+type apiErrorDetail struct {
+	Description string `json:"description"`
+	Error       string `json:"error"`
+}
+
+// diagFromErr converts errors returned by the API into diagnostics. Validation
+// errors get one diagnostic per rejected attribute so that Terraform can
+// point at the offending configuration. Other errors fall back to diag.FromErr.
+func diagFromErr(err error) diag.Diagnostics {
+	var zdErr client.Error
+	if err == nil || !errors.As(err, &zdErr) {
+		return diag.FromErr(err)
+	}
+
+	var body apiErrorBody
+	if json.NewDecoder(zdErr.Body()).Decode(&body) != nil {
+		return diag.FromErr(err)
+	}
+
+	summary := body.summary(zdErr.Status())
+	if len(body.Details) == 0 {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: err.Error()}}
+	}
+
+	keys := make([]string, 0, len(body.Details))
+	for k := range body.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var diags diag.Diagnostics
+	for _, k := range keys {
+		for _, detail := range body.Details[k] {
+			d := diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   detail.Description,
+			}
+			if d.Detail == "" {
+				d.Detail = detail.Error
+			}
+			if !errorDetailGeneral[k] {
+				attr, ok := errorDetailAttributes[k]
+				if !ok {
+					attr = k
+				}
+				d.AttributePath = cty.GetAttrPath(attr)
+			}
+			diags = append(diags, d)
+		}
+	}
+
+	return diags
+}
+
+// summary of the error. "error" is either a code such as "RecordInvalid"
+// or an object with title and message.
+func (b apiErrorBody) summary(status int) string {
+	var code string
+	if json.Unmarshal(b.Error, &code) == nil && code != "" {
+		if b.Description != "" {
+			return fmt.Sprintf("%s: %s", code, b.Description)
+		}
+		return code
+	}
+
+	var obj struct {
+		Title   string `json:"title"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(b.Error, &obj) == nil && obj.Title != "" {
+		if obj.Message != "" {
+			return fmt.Sprintf("%s: %s", obj.Title, obj.Message)
+		}
+		return obj.Title
+	}
+
+	return fmt.Sprintf("Zendesk API returned %d", status)
+}
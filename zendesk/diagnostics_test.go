@@ -0,0 +1,99 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+func newAPIError(status int, body string) error {
+	return zendesk.NewError([]byte(body), &http.Response{StatusCode: status})
+}
+
+func TestDiagFromErrValidationDetails(t *testing.T) {
+	err := newAPIError(http.StatusUnprocessableEntity, `{
+		"error": "RecordInvalid",
+		"description": "Record validation errors",
+		"details": {
+			"title": [{"description": "Title: cannot be blank", "error": "BlankValue"}],
+			"custom_field_options": [{"description": "Custom field options: name is duplicated", "error": "DuplicateValue"}],
+			"base": [{"description": "Account limit reached", "error": "LimitReached"}]
+		}
+	}`)
+
+	diags := diagFromErr(err)
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics. got %d: %v", len(diags), diags)
+	}
+
+	expected := []struct {
+		detail string
+		path   cty.Path
+	}{
+		{"Account limit reached", nil},
+		{"Custom field options: name is duplicated", cty.GetAttrPath("custom_field_option")},
+		{"Title: cannot be blank", cty.GetAttrPath("title")},
+	}
+	for i, e := range expected {
+		d := diags[i]
+		if d.Severity != diag.Error {
+			t.Fatalf("diagnostic %d was not an error", i)
+		}
+		if d.Summary != "RecordInvalid: Record validation errors" {
+			t.Fatalf("diagnostic %d had summary %q", i, d.Summary)
+		}
+		if d.Detail != e.detail {
+			t.Fatalf("diagnostic %d had detail %q. should have been %q", i, d.Detail, e.detail)
+		}
+		if !d.AttributePath.Equals(e.path) {
+			t.Fatalf("diagnostic %d had attribute path %#v. should have been %#v", i, d.AttributePath, e.path)
+		}
+	}
+}
+
+func TestDiagFromErrWithoutDetails(t *testing.T) {
+	cases := []struct {
+		body    string
+		summary string
+	}{
+		{`{"error":{"title":"Forbidden","message":"You do not have access to this page."}}`, "Forbidden: You do not have access to this page."},
+		{`{"error":"InvalidEndpoint","description":"Not found"}`, "InvalidEndpoint: Not found"},
+		{`{}`, "Zendesk API returned 403"},
+	}
+
+	for _, c := range cases {
+		diags := diagFromErr(newAPIError(http.StatusForbidden, c.body))
+		if len(diags) != 1 {
+			t.Fatalf("expected 1 diagnostic. got %d: %v", len(diags), diags)
+		}
+		if diags[0].Summary != c.summary {
+			t.Fatalf("summary was %q. should have been %q", diags[0].Summary, c.summary)
+		}
+		if !strings.Contains(diags[0].Detail, "403") {
+			t.Fatalf("detail %q did not contain the original error", diags[0].Detail)
+		}
+	}
+}
+
+func TestDiagFromErrFallback(t *testing.T) {
+	cases := []error{
+		errors.New("connection refused"),
+		newAPIError(http.StatusBadGateway, `<html>Bad Gateway</html>`),
+	}
+
+	for _, err := range cases {
+		diags := diagFromErr(err)
+		if len(diags) != 1 || diags[0].Summary != err.Error() {
+			t.Fatalf("diagFromErr(%v) should fall back to diag.FromErr. got %v", err, diags)
+		}
+	}
+
+	if diags := diagFromErr(nil); diags != nil {
+		t.Fatalf("diagFromErr(nil) should return nil. got %v", diags)
+	}
+}
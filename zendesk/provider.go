@@ -2,6 +2,10 @@ package zendesk
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +17,23 @@ const (
 	accountVar = "ZENDESK_ACCOUNT"
 	emailVar   = "ZENDESK_EMAIL"
 	tokenVar   = "ZENDESK_TOKEN"
+
+	oauthTokenVar = "ZENDESK_OAUTH_TOKEN"
+	apiURLVar     = "ZENDESK_API_URL"
 )
 
 // Provider returns provider instance for Zendesk
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	return New("dev")
+}
+
+// New returns provider instance for Zendesk which identifies itself
+// with the given version in User-Agent
+func New(version string) *schema.Provider {
+	// Help Center endpoints of brands are looked up once per provider
+	brands := &helpCenterBrands{}
+
+	p := &schema.Provider{
 		// https://developer.zendesk.com/rest_api/docs/support/introduction#security-and-authentication
 		Schema: map[string]*schema.Schema{
 			"account": {
@@ -27,6 +43,25 @@ func Provider() *schema.Provider {
 				DefaultFunc:  schema.EnvDefaultFunc(accountVar, ""),
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"api_url": {
+				Description:  "Full base URL of the API, e.g. `https://example.zendesk.com/api/v2`. Use this to point the provider at a sandbox host, a proxy or a local fake. Conflicts with `account`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(apiURLVar, ""),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"ca_bundle_file": {
+				Description:  "Path to a PEM encoded CA bundle trusted in addition to the system roots, e.g. for TLS interception by a corporate proxy.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: isValidFile(),
+			},
+			"insecure_skip_verify": {
+				Description: "Skip verification of the server TLS certificate. Do not use this outside of testing.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"email": {
 				Description:  "Email address of agent user who have permission to access the API.",
 				Type:         schema.TypeString,
@@ -42,6 +77,41 @@ func Provider() *schema.Provider {
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"oauth_token": {
+				Description:  "[OAuth access token](https://developer.zendesk.com/api-reference/ticketing/oauth/oauth_tokens/) sent as a Bearer credential. Conflicts with `email` and `token`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(oauthTokenVar, ""),
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"max_retries": {
+				Description:  "Maximum number of retries for requests throttled (429) or failed with server errors (5xx). Set to 0 to disable retries.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_backoff": {
+				Description:  "Minimum time in seconds to wait before retrying when Zendesk does not return `Retry-After`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMinBackoff,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_backoff": {
+				Description:  "Maximum time in seconds to wait between retries. Also caps the wait requested by `Retry-After`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxBackoff,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_minute": {
+				Description:  "Maximum number of API requests per minute shared by all resources. Defaults to the limit of the account plan reported by Zendesk in the `X-Rate-Limit` header, starting from 200 until the first response.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -55,35 +125,137 @@ func Provider() *schema.Provider {
 			"zendesk_attachment":   resourceZendeskAttachment(),
 			"zendesk_organization": resourceZendeskOrganization(),
 			"zendesk_sla_policy":   resourceZendeskSLAPolicy(),
+			"zendesk_user":         resourceZendeskUser(),
+
+			"zendesk_group_membership":        resourceZendeskGroupMembership(),
+			"zendesk_group_memberships":       resourceZendeskGroupMemberships(),
+			"zendesk_macro":                   resourceZendeskMacro(),
+			"zendesk_view":                    resourceZendeskView(),
+			"zendesk_webhook":                 resourceZendeskWebhook(),
+			"zendesk_dynamic_content_item":    resourceZendeskDynamicContentItem(),
+			"zendesk_custom_role":             resourceZendeskCustomRole(),
+			"zendesk_schedule":                resourceZendeskSchedule(),
+			"zendesk_schedule_holiday":        resourceZendeskScheduleHoliday(),
+			"zendesk_user_field":              resourceZendeskUserField(),
+			"zendesk_organization_field":      resourceZendeskOrganizationField(),
+			"zendesk_organization_membership": resourceZendeskOrganizationMembership(),
+			"zendesk_custom_status":           resourceZendeskCustomStatus(),
+			"zendesk_help_center_category":    resourceZendeskHelpCenterCategory(brands),
+			"zendesk_help_center_section":     resourceZendeskHelpCenterSection(brands),
+			"zendesk_help_center_article":     resourceZendeskHelpCenterArticle(brands),
+			"zendesk_user_segment":            resourceZendeskUserSegment(),
+			"zendesk_permission_group":        resourceZendeskPermissionGroup(),
+			"zendesk_support_address":         resourceZendeskSupportAddress(),
+			"zendesk_locale_settings":         resourceZendeskLocaleSettings(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"zendesk_ticket_field": dataSourceZendeskTicketField(),
+			"zendesk_locale":       dataSourceZendeskLocale(),
 		},
+	}
 
-		ConfigureContextFunc: providerConfigure,
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		brands.configure(d.Get("api_url").(string) != "")
+		return providerConfigure(ctx, d, userAgent(version, p.TerraformVersion))
 	}
+
+	return p
 }
 
-func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData, userAgent string) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	config := Config{
 		Account: d.Get("account").(string),
 		Email:   d.Get("email").(string),
 		Token:   d.Get("token").(string),
+
+		OAuthToken: d.Get("oauth_token").(string),
+
+		APIURL:             d.Get("api_url").(string),
+		CABundleFile:       d.Get("ca_bundle_file").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		UserAgent:          userAgent,
+
+		MaxRetries: d.Get("max_retries").(int),
+		MinBackoff: time.Duration(d.Get("min_backoff").(int)) * time.Second,
+		MaxBackoff: time.Duration(d.Get("max_backoff").(int)) * time.Second,
+
+		RequestsPerMinute: defaultRequestsPerMinute,
+	}
+
+	if v, ok := d.GetOk("requests_per_minute"); ok {
+		config.RequestsPerMinute = v.(int)
+		config.FixedRateLimit = true
+	}
+
+	if config.MinBackoff > config.MaxBackoff {
+		return nil, diag.Errorf("min_backoff must not be greater than max_backoff")
+	}
+
+	if config.Account != "" && config.APIURL != "" {
+		return nil, diag.Errorf("account conflicts with api_url. configure only one of them")
+	}
+	if config.Account == "" && config.APIURL == "" {
+		return nil, diag.Errorf("either account or api_url must be configured")
+	}
+
+	credential, err := newCredential(config)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	// Create & configure Zendesk API client
-	zd, err := client.NewClient(nil) // TODO: set UserAgent to terraform/version
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	zd, err := client.NewClient(httpClient)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	if err = zd.SetSubdomain(config.Account); err != nil {
+	if config.APIURL != "" {
+		err = zd.SetEndpointURL(strings.TrimSuffix(config.APIURL, "/"))
+	} else {
+		err = zd.SetSubdomain(config.Account)
+	}
+	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	zd.SetCredential(client.NewAPITokenCredential(config.Email, config.Token))
+	zd.SetCredential(credential)
 
 	return zd, diags
 }
+
+// newCredential selects the authentication method. Exactly one of
+// OAuth access token or email + API token must be configured.
+func newCredential(config Config) (client.Credential, error) {
+	apiToken := config.Email != "" || config.Token != ""
+
+	switch {
+	case config.OAuthToken != "" && apiToken:
+		return nil, errors.New("oauth_token conflicts with email and token. configure only one authentication method")
+	case config.OAuthToken != "":
+		return client.NewBearerTokenCredential(config.OAuthToken), nil
+	case config.Email != "" && config.Token != "":
+		return client.NewAPITokenCredential(config.Email, config.Token), nil
+	case apiToken:
+		return nil, errors.New("email and token must be configured together")
+	}
+
+	return nil, errors.New("no credentials configured. set either oauth_token or email and token")
+}
+
+// userAgent identifies requests of this provider, e.g.
+// terraform-provider-zendesk/0.1.0 terraform/1.5.0
+func userAgent(providerVersion, terraformVersion string) string {
+	ua := fmt.Sprintf("terraform-provider-zendesk/%s", providerVersion)
+	if terraformVersion != "" {
+		ua += fmt.Sprintf(" terraform/%s", terraformVersion)
+	}
+
+	return ua
+}
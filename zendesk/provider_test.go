@@ -1,10 +1,17 @@
 package zendesk
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -18,11 +25,13 @@ func init() {
 }
 
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv(emailVar); v == "" {
-		t.Fatalf("%s must be set for acceptance tests", emailVar)
-	}
-	if v := os.Getenv(tokenVar); v == "" {
-		t.Fatalf("%s must be set for acceptance tests", tokenVar)
+	if v := os.Getenv(oauthTokenVar); v == "" {
+		if v := os.Getenv(emailVar); v == "" {
+			t.Fatalf("%s or %s must be set for acceptance tests", oauthTokenVar, emailVar)
+		}
+		if v := os.Getenv(tokenVar); v == "" {
+			t.Fatalf("%s must be set for acceptance tests", tokenVar)
+		}
 	}
 	if v := os.Getenv(accountVar); v == "" {
 		t.Fatalf("%s must be set for acceptance tests", accountVar)
@@ -34,3 +43,219 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+func TestProviderConfigureCredentials(t *testing.T) {
+	cases := []struct {
+		name          string
+		raw           map[string]interface{}
+		authorization string
+		wantErr       bool
+	}{
+		{
+			name:          "api token",
+			raw:           map[string]interface{}{"email": "john.doe@example.com", "token": "xxxx"},
+			authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("john.doe@example.com/token:xxxx")),
+		},
+		{
+			name:          "oauth token",
+			raw:           map[string]interface{}{"oauth_token": "xxxx"},
+			authorization: "Bearer xxxx",
+		},
+		{
+			name:    "oauth token and api token",
+			raw:     map[string]interface{}{"oauth_token": "xxxx", "email": "john.doe@example.com", "token": "xxxx"},
+			wantErr: true,
+		},
+		{
+			name:    "oauth token and email",
+			raw:     map[string]interface{}{"oauth_token": "xxxx", "email": "john.doe@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "email without token",
+			raw:     map[string]interface{}{"email": "john.doe@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "token without email",
+			raw:     map[string]interface{}{"token": "xxxx"},
+			wantErr: true,
+		},
+		{
+			name:    "no credentials",
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, v := range []string{accountVar, emailVar, tokenVar, oauthTokenVar, apiURLVar} {
+				t.Setenv(v, "")
+			}
+
+			var authorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			raw := map[string]interface{}{"api_url": server.URL + "/api/v2"}
+			for k, v := range c.raw {
+				raw[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+			meta, diags := providerConfigure(context.Background(), d, userAgent("test", ""))
+			if c.wantErr {
+				if !diags.HasError() {
+					t.Fatalf("providerConfigure should have returned an error")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("providerConfigure returned an error: %v", diags)
+			}
+
+			if _, err := meta.(*client.Client).Get(context.Background(), "/account.json"); err != nil {
+				t.Fatalf("request through the configured client returned an error: %v", err)
+			}
+			if authorization != c.authorization {
+				t.Fatalf("Authorization header was %q. should have been %q", authorization, c.authorization)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureOAuthTokenFromEnv(t *testing.T) {
+	t.Setenv(emailVar, "")
+	t.Setenv(tokenVar, "")
+	t.Setenv(oauthTokenVar, "xxxx")
+	t.Setenv(apiURLVar, "")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"account": "example"})
+	if _, diags := providerConfigure(context.Background(), d, userAgent("test", "")); diags.HasError() {
+		t.Fatalf("providerConfigure returned an error: %v", diags)
+	}
+}
+
+func TestProviderConfigureAPIURL(t *testing.T) {
+	for _, v := range []string{accountVar, emailVar, tokenVar, oauthTokenVar, apiURLVar} {
+		t.Setenv(v, "")
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/groups/1234.json" {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+		}
+		if v := r.Header.Get("User-Agent"); v != "terraform-provider-zendesk/test" {
+			t.Errorf("unexpected User-Agent %s", v)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"group":{"id":1234,"name":"Support"}}`))
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_url":     ts.URL + "/api/v2/",
+		"oauth_token": "xxxx",
+	})
+	meta, diags := providerConfigure(context.Background(), d, userAgent("test", ""))
+	if diags.HasError() {
+		t.Fatalf("providerConfigure returned an error: %v", diags)
+	}
+
+	group, err := meta.(*client.Client).GetGroup(context.Background(), 1234)
+	if err != nil {
+		t.Fatalf("GetGroup returned an error: %v", err)
+	}
+	if group.Name != "Support" {
+		t.Fatalf("group name was %s. should have been Support", group.Name)
+	}
+}
+
+func TestProviderConfigureEndpointConflicts(t *testing.T) {
+	for _, v := range []string{accountVar, emailVar, tokenVar, oauthTokenVar, apiURLVar} {
+		t.Setenv(v, "")
+	}
+
+	cases := []map[string]interface{}{
+		{"account": "example", "api_url": "https://example.zendesk.com/api/v2", "oauth_token": "xxxx"},
+		{"oauth_token": "xxxx"},
+	}
+
+	for _, raw := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+		if _, diags := providerConfigure(context.Background(), d, userAgent("test", "")); !diags.HasError() {
+			t.Fatalf("providerConfigure should have returned an error for %v", raw)
+		}
+	}
+}
+
+func TestNewHTTPClientTLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := os.WriteFile(bundle, cert, 0600); err != nil {
+		t.Fatalf("failed to write CA bundle: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{"system roots", Config{}, true},
+		{"ca bundle", Config{CABundleFile: bundle}, false},
+		{"insecure", Config{InsecureSkipVerify: true}, false},
+	}
+
+	for _, c := range cases {
+		c.config.RequestsPerMinute = defaultRequestsPerMinute
+		c.config.FixedRateLimit = true
+
+		hc, err := newHTTPClient(c.config)
+		if err != nil {
+			t.Fatalf("%s: newHTTPClient returned an error: %v", c.name, err)
+		}
+
+		resp, err := hc.Get(ts.URL)
+		if c.wantErr {
+			if err == nil {
+				resp.Body.Close()
+				t.Fatalf("%s: request should have failed certificate verification", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: request returned an error: %v", c.name, err)
+		}
+		resp.Body.Close()
+	}
+
+	if _, err := newHTTPClient(Config{CABundleFile: "testdata/README.md"}); err == nil {
+		t.Fatalf("newHTTPClient should have returned an error for a bundle without certificates")
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	cases := []struct {
+		providerVersion  string
+		terraformVersion string
+		expected         string
+	}{
+		{"0.1.0", "1.5.0", "terraform-provider-zendesk/0.1.0 terraform/1.5.0"},
+		{"dev", "", "terraform-provider-zendesk/dev"},
+	}
+
+	for _, c := range cases {
+		if v := userAgent(c.providerVersion, c.terraformVersion); v != c.expected {
+			t.Fatalf("userAgent was %s. should have been %s", v, c.expected)
+		}
+	}
+}
@@ -140,7 +140,7 @@ func createAttachment(ctx context.Context, d identifiableGetterSetter, zd zendes
 
 	result, err := w.Close()
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	a := result.Attachment
@@ -175,7 +175,7 @@ func deleteAttachment(ctx context.Context, d identifiableGetterSetter, zd zendes
 
 	err := zd.DeleteUpload(ctx, v.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -200,8 +200,11 @@ func readAttachment(ctx context.Context, d identifiableGetterSetter, zd zendesk.
 	}
 
 	a, err := zd.GetAttachment(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Attachment")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	out.Attachment = a
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
 	"github.com/nukosuke/go-zendesk/zendesk/mock"
@@ -115,6 +116,27 @@ func TestDeleteZendeskAttachmentDoesNotCallWhenTokenIsNotSet(t *testing.T) {
 	}
 }
 
+func TestReadZendeskAttachmentNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetAttachment(Any(), Eq(int64(12345))).Return(zendesk.Attachment{}, newNotFoundError())
+	diags := readAttachment(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readAttachment returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readAttachment should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readAttachment did not remove resource from state. Id was %s", v)
+	}
+}
+
 func testAttachmentDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(zendesk.AttachmentAPI)
 
@@ -231,7 +231,7 @@ func createAutomation(ctx context.Context, d identifiableGetterSetter, zd client
 
 	automation, err = zd.CreateAutomation(ctx, automation)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", automation.ID))
@@ -253,8 +253,11 @@ func readAutomation(ctx context.Context, d identifiableGetterSetter, zd client.A
 	}
 
 	automation, err := zd.GetAutomation(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Automation")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalAutomation(automation, d)
@@ -280,7 +283,7 @@ func updateAutomation(ctx context.Context, d identifiableGetterSetter, zd client
 
 	automation, err = zd.UpdateAutomation(ctx, id, automation)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalAutomation(automation, d)
@@ -301,7 +304,7 @@ func deleteAutomation(ctx context.Context, d identifiable, zd client.AutomationA
 
 	err = zd.DeleteAutomation(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
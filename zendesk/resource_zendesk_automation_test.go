@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -107,6 +108,27 @@ func TestReadAutomation(t *testing.T) {
 	}
 }
 
+func TestReadAutomationNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetAutomation(gomock.Any(), gomock.Eq(int64(12345))).Return(zendesk.Automation{}, newNotFoundError())
+	diags := readAutomation(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readAutomation returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readAutomation should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readAutomation did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestUpdateAutomation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -196,7 +196,7 @@ func createBrand(ctx context.Context, d identifiableGetterSetter, zd client.Bran
 
 	brand, err = zd.CreateBrand(ctx, brand)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", brand.ID))
@@ -218,8 +218,11 @@ func readBrand(ctx context.Context, d identifiableGetterSetter, zd client.BrandA
 	}
 
 	brand, err := zd.GetBrand(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Brand")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalBrand(brand, d)
@@ -245,7 +248,7 @@ func updateBrand(ctx context.Context, d identifiableGetterSetter, zd client.Bran
 
 	brand, err = zd.UpdateBrand(ctx, id, brand)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalBrand(brand, d)
@@ -266,7 +269,7 @@ func deleteBrand(ctx context.Context, d identifiableGetterSetter, zd client.Bran
 
 	err = zd.DeleteBrand(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
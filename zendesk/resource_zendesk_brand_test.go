@@ -9,6 +9,7 @@ import (
 	"time"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -86,6 +87,27 @@ func TestReadBrand(t *testing.T) {
 	}
 }
 
+func TestReadBrandNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetBrand(Any(), Eq(int64(12345))).Return(zendesk.Brand{}, newNotFoundError())
+	diags := readBrand(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readBrand returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readBrand should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readBrand did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestUpdateBrand(t *testing.T) {
 	updatedBrand := testBrand
 	updatedBrand.Name = "1234"
@@ -0,0 +1,323 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// Permissions of a custom role which are either granted or not
+var customRoleBoolPermissions = map[string]string{
+	"assign_tickets_to_any_group":     "Whether the agent can assign tickets to any group.",
+	"chat_access":                     "Whether the agent has access to Chat.",
+	"forum_access_restricted_content": "Whether the agent can access restricted content in the Help Center.",
+	"group_access":                    "Whether the agent can add or modify groups.",
+	"light_agent":                     "Whether the role is a light agent role.",
+	"manage_business_rules":           "Whether the agent can manage business rules.",
+	"manage_contextual_workspaces":    "Whether the agent can manage contextual workspaces.",
+	"manage_dynamic_content":          "Whether the agent can manage dynamic content.",
+	"manage_extensions_and_channels":  "Whether the agent can manage channels and extensions.",
+	"manage_facebook":                 "Whether the agent can manage Facebook pages.",
+	"manage_organization_fields":      "Whether the agent can create and manage organization fields.",
+	"manage_ticket_fields":            "Whether the agent can create and manage ticket fields.",
+	"manage_ticket_forms":             "Whether the agent can create and manage ticket forms.",
+	"manage_user_fields":              "Whether the agent can create and manage user fields.",
+	"moderate_forums":                 "Whether the agent can moderate the Help Center community.",
+	"organization_editing":            "Whether the agent can add or modify organizations.",
+	"organization_notes_editing":      "Whether the agent can add or modify organization notes.",
+	"side_conversation_create":        "Whether the agent can start side conversations.",
+	"ticket_deletion":                 "Whether the agent can delete tickets.",
+	"ticket_editing":                  "Whether the agent can edit ticket properties.",
+	"ticket_merge":                    "Whether the agent can merge tickets.",
+	"ticket_tag_editing":              "Whether the agent can edit ticket tags.",
+	"twitter_search_access":           "Whether the agent can search Twitter.",
+	"view_deleted_tickets":            "Whether the agent can view deleted tickets.",
+	"voice_access":                    "Whether the agent can answer and place calls.",
+	"voice_dashboard_access":          "Whether the agent can view the Talk dashboard.",
+}
+
+// Permissions of a custom role which take one of several access levels
+var customRoleStringPermissions = map[string][]string{
+	"end_user_list_access":    {"full", "none"},
+	"end_user_profile_access": {"edit", "edit-within-org", "full", "readonly"},
+	"explore_access":          {"edit", "full", "none", "readonly"},
+	"forum_access":            {"edit-topics", "full", "readonly"},
+	"macro_access":            {"full", "manage-group", "manage-personal", "readonly"},
+	"report_access":           {"full", "none", "readonly"},
+	"ticket_access":           {"all", "assigned-only", "within-groups", "within-groups-and-public-groups", "within-organization"},
+	"ticket_comment_access":   {"none", "public"},
+	"user_view_access":        {"full", "manage-group", "manage-personal", "none", "readonly"},
+	"view_access":             {"full", "manage-group", "manage-personal", "playonly", "readonly"},
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/
+func resourceZendeskCustomRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a custom agent role resource. Custom roles are available on Enterprise plans.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createCustomRole(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readCustomRole(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateCustomRole(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteCustomRole(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the custom role.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "A description of the role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"team_member_count": {
+				Description: "The number of agents assigned to the role.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"configuration": {
+				Description: "The permissions of the role. Permissions which are granted or not default to false, and access levels left unset keep the value chosen by Zendesk.",
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: customRoleConfigurationSchema(),
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func customRoleConfigurationSchema() map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema)
+
+	for k, desc := range customRoleBoolPermissions {
+		s[k] = &schema.Schema{
+			Description: desc,
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		}
+	}
+
+	for k, values := range customRoleStringPermissions {
+		s[k] = &schema.Schema{
+			Description:  fmt.Sprintf("The access level. Possible values are `%s`.", strings.Join(values, "`, `")),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(values, false),
+		}
+	}
+
+	return s
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalCustomRole(role client.CustomRole, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":              role.Name,
+		"description":       role.Description,
+		"team_member_count": role.TeamMemberCount,
+	}
+
+	configuration := make(map[string]interface{})
+	for k := range customRoleBoolPermissions {
+		v, _ := role.Configuration[k].(bool)
+		configuration[k] = v
+	}
+	for k := range customRoleStringPermissions {
+		v, _ := role.Configuration[k].(string)
+		configuration[k] = v
+	}
+	fields["configuration"] = []map[string]interface{}{configuration}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalCustomRole(d identifiableGetterSetter) (client.CustomRole, error) {
+	role := client.CustomRole{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return role, fmt.Errorf("could not parse custom role id %s: %v", v, err)
+		}
+		role.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		role.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		role.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		configurations := v.([]interface{})
+		configuration, ok := configurations[0].(map[string]interface{})
+		if !ok {
+			return role, fmt.Errorf("could not parse configuration for custom role %v", role.Name)
+		}
+
+		role.Configuration = client.Configuration{}
+		for k := range customRoleBoolPermissions {
+			if v, ok := configuration[k].(bool); ok {
+				role.Configuration[k] = v
+			}
+		}
+		for k := range customRoleStringPermissions {
+			if v, ok := configuration[k].(string); ok && v != "" {
+				role.Configuration[k] = v
+			}
+		}
+	}
+
+	return role, nil
+}
+
+func createCustomRole(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	role, err := unmarshalCustomRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/custom_roles.json", customRolePayload(role))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	role, err = decodeCustomRole(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", role.ID))
+
+	err = marshalCustomRole(role, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomRole(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/custom_roles/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Custom role")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	role, err := decodeCustomRole(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomRole(role, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateCustomRole(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	role, err := unmarshalCustomRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/custom_roles/%d.json", role.ID), customRolePayload(role))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	role, err = decodeCustomRole(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomRole(role, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteCustomRole(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/custom_roles/%d.json", id))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// customRolePayload leaves out the read-only attributes of client.CustomRole
+func customRolePayload(role client.CustomRole) map[string]interface{} {
+	return map[string]interface{}{
+		"custom_role": map[string]interface{}{
+			"name":          role.Name,
+			"description":   role.Description,
+			"configuration": role.Configuration,
+		},
+	}
+}
+
+func decodeCustomRole(body []byte) (client.CustomRole, error) {
+	var result struct {
+		CustomRole client.CustomRole `json:"custom_role"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.CustomRole, err
+}
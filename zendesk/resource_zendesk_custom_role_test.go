@@ -0,0 +1,181 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalCustomRole(t *testing.T) {
+	expected := zendesk.CustomRole{
+		Name:            "Team lead",
+		Description:     "Leads a support team",
+		TeamMemberCount: 3,
+		Configuration: zendesk.Configuration{
+			"ticket_editing":        true,
+			"manage_business_rules": false,
+			"view_access":           "manage-group",
+			"unknown_permission":    true,
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	err := marshalCustomRole(expected, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	if v := m.Get("name"); v != expected.Name {
+		t.Fatalf("custom role had name %v. should have been %v", v, expected.Name)
+	}
+
+	configuration := m.Get("configuration").([]map[string]interface{})[0]
+	cases := map[string]interface{}{
+		"ticket_editing":        true,
+		"manage_business_rules": false,
+		"ticket_deletion":       false,
+		"view_access":           "manage-group",
+		"macro_access":          "",
+	}
+	for k, expectedValue := range cases {
+		if v := configuration[k]; v != expectedValue {
+			t.Fatalf("custom role had configuration.%s %v. should have been %v", k, v, expectedValue)
+		}
+	}
+	if _, ok := configuration["unknown_permission"]; ok {
+		t.Fatalf("custom role should not set unknown permissions")
+	}
+}
+
+func TestUnmarshalCustomRole(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name": "Team lead",
+			"configuration": []interface{}{
+				map[string]interface{}{
+					"ticket_editing": true,
+					"ticket_merge":   false,
+					"view_access":    "manage-group",
+					"macro_access":   "",
+				},
+			},
+		},
+	}
+
+	role, err := unmarshalCustomRole(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if role.ID != 1234 {
+		t.Fatalf("custom role had id %v. should have been 1234", role.ID)
+	}
+	if v := role.Configuration["ticket_editing"]; v != true {
+		t.Fatalf("custom role had ticket_editing %v. should have been true", v)
+	}
+	if v, ok := role.Configuration["ticket_merge"]; !ok || v != false {
+		t.Fatalf("custom role should send ticket_merge=false. got %v", v)
+	}
+	if v := role.Configuration["view_access"]; v != "manage-group" {
+		t.Fatalf("custom role had view_access %v. should have been manage-group", v)
+	}
+	if v, ok := role.Configuration["macro_access"]; ok {
+		t.Fatalf("custom role should not send unset macro_access. got %v", v)
+	}
+}
+
+func TestCreateCustomRole(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name": "Team lead",
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/custom_roles.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			CustomRole map[string]interface{} `json:"custom_role"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if _, ok := req.CustomRole["team_member_count"]; ok {
+			t.Fatalf("create request should not send read-only attributes: %s", b)
+		}
+
+		return []byte(`{"custom_role":{"id":1234,"name":"Team lead","configuration":{"ticket_editing":true}}}`), nil
+	})
+	if diags := createCustomRole(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createCustomRole returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createCustomRole did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadCustomRoleNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/custom_roles/1234.json")).Return(nil, newNotFoundError())
+	if diags := readCustomRole(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readCustomRole returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readCustomRole did not remove custom role from state. Id was %s", v)
+	}
+}
+
+func TestUpdateCustomRole(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name": "Team lead",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/custom_roles/1234.json"), Any()).Return([]byte(`{"custom_role":{"id":1234,"name":"Team lead"}}`), nil)
+	if diags := updateCustomRole(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateCustomRole returned an error: %v", diags)
+	}
+}
+
+func TestDeleteCustomRole(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/custom_roles/1234.json")).Return(nil)
+	if diags := deleteCustomRole(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteCustomRole returned an error: %v", diags)
+	}
+}
@@ -0,0 +1,290 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type customStatus struct {
+	ID                 int64  `json:"id,omitempty"`
+	StatusCategory     string `json:"status_category"`
+	AgentLabel         string `json:"agent_label"`
+	EndUserLabel       string `json:"end_user_label"`
+	Description        string `json:"description"`
+	EndUserDescription string `json:"end_user_description"`
+	Active             bool   `json:"active"`
+	Default            bool   `json:"default,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+func resourceZendeskCustomStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a custom ticket status resource. Custom statuses cannot be deleted, so destroying the resource deactivates the status. " +
+			"Set the `custom_status_id` field of a trigger action to the status id to move tickets into it.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createCustomStatus(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readCustomStatus(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateCustomStatus(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteCustomStatus(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"status_category": {
+				Description: "The status category the custom status belongs to. Changing the category forces a new status.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"new",
+					"open",
+					"pending",
+					"hold",
+					"solved",
+				}, false),
+			},
+			"agent_label": {
+				Description: "The label displayed to agents.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"end_user_label": {
+				Description: "The label displayed to end users. Defaults to the label of the status category.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description displayed to agents.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"end_user_description": {
+				Description: "The description displayed to end users.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the custom status is available to agents.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"default": {
+				Description: "Whether the custom status is the default of its status category.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalCustomStatus(status customStatus, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"status_category":      status.StatusCategory,
+		"agent_label":          status.AgentLabel,
+		"end_user_label":       status.EndUserLabel,
+		"description":          status.Description,
+		"end_user_description": status.EndUserDescription,
+		"active":               status.Active,
+		"default":              status.Default,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalCustomStatus(d identifiableGetterSetter) (customStatus, error) {
+	status := customStatus{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return status, fmt.Errorf("could not parse custom status id %s: %v", v, err)
+		}
+		status.ID = id
+	}
+
+	if v, ok := d.GetOk("status_category"); ok {
+		status.StatusCategory = v.(string)
+	}
+
+	if v, ok := d.GetOk("agent_label"); ok {
+		status.AgentLabel = v.(string)
+	}
+
+	if v, ok := d.GetOk("end_user_label"); ok {
+		status.EndUserLabel = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		status.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("end_user_description"); ok {
+		status.EndUserDescription = v.(string)
+	}
+
+	if v, ok := d.GetOk("active"); ok {
+		status.Active = v.(bool)
+	}
+
+	return status, nil
+}
+
+func createCustomStatus(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status, err := unmarshalCustomStatus(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/custom_statuses.json", customStatusPayload(status))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	status, err = decodeCustomStatus(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", status.ID))
+
+	err = marshalCustomStatus(status, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomStatus(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/custom_statuses/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Custom status")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	status, err := decodeCustomStatus(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomStatus(status, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateCustomStatus(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status, err := unmarshalCustomStatus(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/custom_statuses/%d.json", status.ID), customStatusPayload(status))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	status, err = decodeCustomStatus(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomStatus(status, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// deleteCustomStatus deactivates the status since Zendesk doesn't delete custom statuses
+func deleteCustomStatus(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"custom_status": map[string]interface{}{
+			"active": false,
+		},
+	}
+	_, err = zd.Put(ctx, fmt.Sprintf("/custom_statuses/%d.json", id), payload)
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// customStatusPayload leaves out the read-only attributes of customStatus
+func customStatusPayload(status customStatus) map[string]interface{} {
+	payload := map[string]interface{}{
+		"agent_label":          status.AgentLabel,
+		"description":          status.Description,
+		"end_user_description": status.EndUserDescription,
+		"active":               status.Active,
+	}
+
+	// The category can only be set on creation
+	if status.ID == 0 {
+		payload["status_category"] = status.StatusCategory
+	}
+
+	if status.EndUserLabel != "" {
+		payload["end_user_label"] = status.EndUserLabel
+	}
+
+	return map[string]interface{}{"custom_status": payload}
+}
+
+func decodeCustomStatus(body []byte) (customStatus, error) {
+	var result struct {
+		CustomStatus customStatus `json:"custom_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.CustomStatus, err
+}
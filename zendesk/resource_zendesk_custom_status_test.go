@@ -0,0 +1,158 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testCustomStatusResponse = `{
+  "custom_status": {
+    "id": 1234,
+    "status_category": "pending",
+    "agent_label": "Waiting on vendor",
+    "end_user_label": "Pending",
+    "description": "Waiting for a reply from a vendor",
+    "active": true,
+    "default": false
+  }
+}`
+
+func TestMarshalCustomStatus(t *testing.T) {
+	status, err := decodeCustomStatus([]byte(testCustomStatusResponse))
+	if err != nil {
+		t.Fatalf("Failed to decode custom status %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	err = marshalCustomStatus(status, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"status_category": "pending",
+		"agent_label":     "Waiting on vendor",
+		"end_user_label":  "Pending",
+		"active":          true,
+	}
+	for k, v := range expected {
+		if m.Get(k) != v {
+			t.Fatalf("custom status had %s %v. should have been %v", k, m.Get(k), v)
+		}
+	}
+}
+
+func TestCreateCustomStatus(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"status_category": "pending",
+			"agent_label":     "Waiting on vendor",
+			"active":          true,
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/custom_statuses.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			CustomStatus map[string]interface{} `json:"custom_status"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if v := req.CustomStatus["status_category"]; v != "pending" {
+			t.Fatalf("create request should send status_category. got %s", b)
+		}
+		if _, ok := req.CustomStatus["end_user_label"]; ok {
+			t.Fatalf("create request should not send an empty end_user_label. got %s", b)
+		}
+
+		return []byte(testCustomStatusResponse), nil
+	})
+	if diags := createCustomStatus(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createCustomStatus returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createCustomStatus did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadCustomStatusNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/custom_statuses/1234.json")).Return(nil, newNotFoundError())
+	if diags := readCustomStatus(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readCustomStatus returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readCustomStatus did not remove custom status from state. Id was %s", v)
+	}
+}
+
+func TestUpdateCustomStatus(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"status_category": "pending",
+			"agent_label":     "Waiting on vendor",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/custom_statuses/1234.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		payload := data.(map[string]interface{})["custom_status"].(map[string]interface{})
+		if _, ok := payload["status_category"]; ok {
+			t.Fatalf("update request should not send status_category. got %v", payload)
+		}
+
+		return []byte(testCustomStatusResponse), nil
+	})
+	if diags := updateCustomStatus(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateCustomStatus returned an error: %v", diags)
+	}
+}
+
+func TestDeleteCustomStatusDeactivates(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Put(Any(), Eq("/custom_statuses/1234.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		payload := data.(map[string]interface{})["custom_status"].(map[string]interface{})
+		if v := payload["active"]; v != false {
+			t.Fatalf("delete should deactivate the custom status. got %v", payload)
+		}
+
+		return []byte(testCustomStatusResponse), nil
+	})
+	if diags := deleteCustomStatus(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteCustomStatus returned an error: %v", diags)
+	}
+}
@@ -0,0 +1,328 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// go-zendesk omits inactive variants' active flag and has no variant
+// endpoints, so dynamic content is requested with BaseAPI.
+type dynamicContentItem struct {
+	ID              int64                   `json:"id,omitempty"`
+	URL             string                  `json:"url,omitempty"`
+	Name            string                  `json:"name"`
+	Placeholder     string                  `json:"placeholder,omitempty"`
+	DefaultLocaleID int64                   `json:"default_locale_id"`
+	Variants        []dynamicContentVariant `json:"variants,omitempty"`
+}
+
+type dynamicContentVariant struct {
+	ID       int64  `json:"id,omitempty"`
+	LocaleID int64  `json:"locale_id"`
+	Content  string `json:"content"`
+	Active   bool   `json:"active"`
+	Default  bool   `json:"default"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/dynamic_content/
+func resourceZendeskDynamicContentItem() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a dynamic content item resource, which is referred to by its placeholder in macros, triggers and automations.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createDynamicContentItem(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readDynamicContentItem(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateDynamicContentItem(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteDynamicContentItem(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "The API url of this item.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The unique name of the item.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"placeholder": {
+				Description: "Automatically generated placeholder for the item, derived from name, e.g. `{{dc.welcome_message}}`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"default_locale_id": {
+				Description: "The default locale for the item. Must be the locale of one of the variants.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"variant": {
+				Description: "The content of the item per locale. Variants are created, updated and deleted individually.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"locale_id": {
+							Description: "An active locale.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"content": {
+							Description: "The content of the variant.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"active": {
+							Description: "If the variant is active and useable.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"default": {
+							Description: "If the variant is the default for the item it belongs to.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalDynamicContentItem(item dynamicContentItem, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":               item.URL,
+		"name":              item.Name,
+		"placeholder":       item.Placeholder,
+		"default_locale_id": item.DefaultLocaleID,
+	}
+
+	var variants []map[string]interface{}
+	for _, v := range item.Variants {
+		variants = append(variants, map[string]interface{}{
+			"locale_id": v.LocaleID,
+			"content":   v.Content,
+			"active":    v.Active,
+			"default":   v.Default,
+		})
+	}
+	fields["variant"] = variants
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalDynamicContentItem(d identifiableGetterSetter) (dynamicContentItem, error) {
+	item := dynamicContentItem{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return item, fmt.Errorf("could not parse dynamic content item id %s: %v", v, err)
+		}
+		item.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		item.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("default_locale_id"); ok {
+		item.DefaultLocaleID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("variant"); ok {
+		for _, e := range v.(*schema.Set).List() {
+			variant, ok := e.(map[string]interface{})
+			if !ok {
+				return item, fmt.Errorf("could not parse variants for dynamic content item %v", item.Name)
+			}
+
+			item.Variants = append(item.Variants, dynamicContentVariant{
+				LocaleID: int64(variant["locale_id"].(int)),
+				Content:  variant["content"].(string),
+				Active:   variant["active"].(bool),
+				Default:  variant["default"].(bool),
+			})
+		}
+	}
+
+	return item, nil
+}
+
+func createDynamicContentItem(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	item, err := unmarshalDynamicContentItem(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/dynamic_content/items.json", map[string]interface{}{"item": item})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	item, err = decodeDynamicContentItem(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", item.ID))
+
+	err = marshalDynamicContentItem(item, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readDynamicContentItem(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Dynamic content item")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	item, err := decodeDynamicContentItem(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalDynamicContentItem(item, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// updateDynamicContentItem updates the item and reconciles its variants by locale.
+// New variants are created before existing ones are updated, so that a new
+// default variant exists, and variants are deleted last since the default
+// variant can't be deleted.
+func updateDynamicContentItem(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	item, err := unmarshalDynamicContentItem(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", item.ID))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	current, err := decodeDynamicContentItem(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing := make(map[int64]dynamicContentVariant)
+	for _, v := range current.Variants {
+		existing[v.LocaleID] = v
+	}
+
+	var updated []dynamicContentVariant
+	for _, v := range item.Variants {
+		e, ok := existing[v.LocaleID]
+		if !ok {
+			path := fmt.Sprintf("/dynamic_content/items/%d/variants.json", item.ID)
+			if _, err := zd.Post(ctx, path, map[string]interface{}{"variant": v}); err != nil {
+				return diagFromErr(err)
+			}
+			continue
+		}
+
+		delete(existing, v.LocaleID)
+		if v.Content != e.Content || v.Active != e.Active || v.Default != e.Default {
+			v.ID = e.ID
+			updated = append(updated, v)
+		}
+	}
+
+	for _, v := range updated {
+		path := fmt.Sprintf("/dynamic_content/items/%d/variants/%d.json", item.ID, v.ID)
+		if _, err := zd.Put(ctx, path, map[string]interface{}{"variant": v}); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if item.Name != current.Name || item.DefaultLocaleID != current.DefaultLocaleID {
+		payload := map[string]interface{}{
+			"item": map[string]interface{}{
+				"name":              item.Name,
+				"default_locale_id": item.DefaultLocaleID,
+			},
+		}
+		if _, err := zd.Put(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", item.ID), payload); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	for _, v := range existing {
+		path := fmt.Sprintf("/dynamic_content/items/%d/variants/%d.json", item.ID, v.ID)
+		if err := zd.Delete(ctx, path); err != nil && !isNotFound(err) {
+			return diagFromErr(err)
+		}
+	}
+
+	return readDynamicContentItem(ctx, d, zd)
+}
+
+func deleteDynamicContentItem(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", id))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeDynamicContentItem(body []byte) (dynamicContentItem, error) {
+	var result struct {
+		Item dynamicContentItem `json:"item"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Item, err
+}
@@ -0,0 +1,182 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testDynamicContentItemResponse = `{
+  "item": {
+    "id": 1234,
+    "name": "welcome_message",
+    "placeholder": "{{dc.welcome_message}}",
+    "default_locale_id": 1,
+    "variants": [
+      {"id": 11, "locale_id": 1, "content": "Welcome", "active": true, "default": true},
+      {"id": 12, "locale_id": 67, "content": "ようこそ", "active": false, "default": false}
+    ]
+  }
+}`
+
+func testDynamicContentVariants(variants ...map[string]interface{}) *schema.Set {
+	elem := resourceZendeskDynamicContentItem().Schema["variant"].Elem.(*schema.Resource)
+	list := make([]interface{}, 0, len(variants))
+	for _, v := range variants {
+		list = append(list, v)
+	}
+	return schema.NewSet(schema.HashResource(elem), list)
+}
+
+func TestMarshalDynamicContentItem(t *testing.T) {
+	item, err := decodeDynamicContentItem([]byte(testDynamicContentItemResponse))
+	if err != nil {
+		t.Fatalf("Failed to decode item %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	err = marshalDynamicContentItem(item, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	if v := m.Get("placeholder"); v != "{{dc.welcome_message}}" {
+		t.Fatalf("item had placeholder %v. should have been {{dc.welcome_message}}", v)
+	}
+	if v := m.Get("default_locale_id"); v != int64(1) {
+		t.Fatalf("item had default_locale_id %v. should have been 1", v)
+	}
+
+	variants := m.Get("variant").([]map[string]interface{})
+	if len(variants) != 2 {
+		t.Fatalf("item had %d variants. should have been 2", len(variants))
+	}
+	if variants[1]["content"] != "ようこそ" || variants[1]["active"] != false {
+		t.Fatalf("item had incorrect variant %v", variants[1])
+	}
+}
+
+func TestUnmarshalDynamicContentItem(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":              "welcome_message",
+			"default_locale_id": 1,
+			"variant": testDynamicContentVariants(
+				map[string]interface{}{"locale_id": 1, "content": "Welcome", "active": true, "default": true},
+			),
+		},
+	}
+
+	item, err := unmarshalDynamicContentItem(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if item.ID != 1234 || item.Name != "welcome_message" || item.DefaultLocaleID != 1 {
+		t.Fatalf("item had incorrect values %v", item)
+	}
+	if len(item.Variants) != 1 || !item.Variants[0].Default {
+		t.Fatalf("item had incorrect variants %v", item.Variants)
+	}
+}
+
+func TestCreateDynamicContentItem(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+
+	m.EXPECT().Post(Any(), Eq("/dynamic_content/items.json"), Any()).Return([]byte(testDynamicContentItemResponse), nil)
+	if diags := createDynamicContentItem(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createDynamicContentItem returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createDynamicContentItem did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadDynamicContentItemNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/dynamic_content/items/1234.json")).Return(nil, newNotFoundError())
+	if diags := readDynamicContentItem(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readDynamicContentItem returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readDynamicContentItem did not remove item from state. Id was %s", v)
+	}
+}
+
+func TestUpdateDynamicContentItem(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":              "welcome_message",
+			"default_locale_id": 1,
+			"variant": testDynamicContentVariants(
+				map[string]interface{}{"locale_id": 1, "content": "Welcome!", "active": true, "default": true},
+				map[string]interface{}{"locale_id": 8, "content": "Willkommen", "active": true, "default": false},
+			),
+		},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/dynamic_content/items/1234.json")).Return([]byte(testDynamicContentItemResponse), nil).Times(2)
+	m.EXPECT().Post(Any(), Eq("/dynamic_content/items/1234/variants.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			Variant dynamicContentVariant `json:"variant"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if req.Variant.LocaleID != 8 || req.Variant.Content != "Willkommen" {
+			t.Fatalf("created unexpected variant: %s", b)
+		}
+
+		return []byte(`{"variant":{"id":13}}`), nil
+	})
+	m.EXPECT().Put(Any(), Eq("/dynamic_content/items/1234/variants/11.json"), Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Delete(Any(), Eq("/dynamic_content/items/1234/variants/12.json")).Return(nil)
+
+	if diags := updateDynamicContentItem(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateDynamicContentItem returned an error: %v", diags)
+	}
+}
+
+func TestDeleteDynamicContentItem(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/dynamic_content/items/1234.json")).Return(nil)
+	if diags := deleteDynamicContentItem(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteDynamicContentItem returned an error: %v", diags)
+	}
+}
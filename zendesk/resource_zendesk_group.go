@@ -94,7 +94,7 @@ func createGroup(ctx context.Context, d identifiableGetterSetter, zd client.Grou
 	// Actual API request
 	group, err = zd.CreateGroup(ctx, group)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", group.ID))
@@ -116,8 +116,11 @@ func readGroup(ctx context.Context, d identifiableGetterSetter, zd client.GroupA
 	}
 
 	group, err := zd.GetGroup(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Group")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalGroup(group, d)
@@ -144,7 +147,7 @@ func updateGroup(ctx context.Context, d identifiableGetterSetter, zd client.Grou
 	// ActualAPI request
 	group, err = zd.UpdateGroup(ctx, id, group)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalGroup(group, d)
@@ -165,7 +168,7 @@ func deleteGroup(ctx context.Context, d identifiable, zd client.GroupAPI) diag.D
 
 	err = zd.DeleteGroup(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -0,0 +1,282 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+func resourceZendeskGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a group membership resource, which assigns an agent to a group.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createGroupMembership(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readGroupMembership(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateGroupMembership(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteGroupMembership(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				zd := meta.(*client.Client)
+				if err := importGroupMembership(ctx, d, zd); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "The API url of this group membership.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "The id of an agent.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Description: "The id of a group.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default": {
+				Description:      "If true, tickets assigned directly to the agent will assume this membership's group. The default can't be unset, only moved by making another membership of the agent the default, so false is ignored.",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressUnsetDefault,
+			},
+		},
+	}
+}
+
+func marshalGroupMembership(m client.GroupMembership, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":      m.URL,
+		"user_id":  m.UserID,
+		"group_id": m.GroupID,
+		"default":  m.Default,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalGroupMembership(d identifiableGetterSetter) (client.GroupMembership, error) {
+	m := client.GroupMembership{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return m, fmt.Errorf("could not parse group membership id %s: %v", v, err)
+		}
+		m.ID = id
+	}
+
+	if v, ok := d.GetOk("url"); ok {
+		m.URL = v.(string)
+	}
+
+	if v, ok := d.GetOk("user_id"); ok {
+		m.UserID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("group_id"); ok {
+		m.GroupID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("default"); ok {
+		m.Default = v.(bool)
+	}
+
+	return m, nil
+}
+
+func createGroupMembership(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m, err := unmarshalGroupMembership(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	m, err = postGroupMembership(ctx, zd, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", m.ID))
+
+	err = marshalGroupMembership(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readGroupMembership(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	m, err := fetchGroupMembership(ctx, zd, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Group membership")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = marshalGroupMembership(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// updateGroupMembership only handles default since the other attributes force a new membership
+func updateGroupMembership(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	m, err := unmarshalGroupMembership(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if m.Default {
+		path := fmt.Sprintf("/users/%d/group_memberships/%d/make_default.json", m.UserID, m.ID)
+		if _, err := zd.Put(ctx, path, map[string]interface{}{}); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readGroupMembership(ctx, d, zd)
+}
+
+func deleteGroupMembership(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/group_memberships/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// importGroupMembership accepts either the membership id or group_id:user_id
+func importGroupMembership(ctx context.Context, d identifiable, zd client.BaseAPI) error {
+	if _, err := atoi64(d.Id()); err == nil {
+		return nil
+	}
+
+	ids, err := parseCompositeID(d.Id(), "group_id", "user_id")
+	if err != nil {
+		return err
+	}
+	groupID, userID := ids[0], ids[1]
+
+	memberships, err := fetchGroupMemberships(ctx, zd, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range memberships {
+		if m.UserID == userID {
+			d.SetId(fmt.Sprintf("%d", m.ID))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("user %d is not a member of group %d", userID, groupID)
+}
+
+// go-zendesk only lists group memberships, so the rest is requested directly
+
+func fetchGroupMembership(ctx context.Context, zd client.BaseAPI, id int64) (client.GroupMembership, error) {
+	var result struct {
+		GroupMembership client.GroupMembership `json:"group_membership"`
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/group_memberships/%d.json", id))
+	if err != nil {
+		return client.GroupMembership{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result.GroupMembership, err
+}
+
+func fetchGroupMemberships(ctx context.Context, zd client.BaseAPI, groupID int64) ([]client.GroupMembership, error) {
+	var memberships []client.GroupMembership
+
+	for page := 1; ; page++ {
+		var result struct {
+			GroupMemberships []client.GroupMembership `json:"group_memberships"`
+			NextPage         *string                  `json:"next_page"`
+		}
+
+		body, err := zd.Get(ctx, fmt.Sprintf("/groups/%d/memberships.json?page=%d", groupID, page))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, result.GroupMemberships...)
+		if result.NextPage == nil || len(result.GroupMemberships) == 0 {
+			return memberships, nil
+		}
+	}
+}
+
+func postGroupMembership(ctx context.Context, zd client.BaseAPI, m client.GroupMembership) (client.GroupMembership, error) {
+	var result struct {
+		GroupMembership client.GroupMembership `json:"group_membership"`
+	}
+
+	payload := map[string]interface{}{
+		"group_membership": map[string]interface{}{
+			"user_id":  m.UserID,
+			"group_id": m.GroupID,
+			"default":  m.Default,
+		},
+	}
+
+	body, err := zd.Post(ctx, "/group_memberships.json", payload)
+	if err != nil {
+		return client.GroupMembership{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result.GroupMembership, err
+}
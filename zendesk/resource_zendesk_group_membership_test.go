@@ -0,0 +1,214 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalGroupMembership(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	gm := zendesk.GroupMembership{
+		URL:     "https://example.zendesk.com/api/v2/group_memberships/4.json",
+		UserID:  1,
+		GroupID: 2,
+		Default: true,
+	}
+
+	err := marshalGroupMembership(gm, m)
+	if err != nil {
+		t.Fatalf("Could not marshal map %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"url":      gm.URL,
+		"user_id":  gm.UserID,
+		"group_id": gm.GroupID,
+		"default":  gm.Default,
+	}
+	for k, v := range expected {
+		if m.Get(k) != v {
+			t.Fatalf("group membership had incorrect %s value %v. should have been %v", k, m.Get(k), v)
+		}
+	}
+}
+
+func TestUnmarshalGroupMembership(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "4",
+		mapGetterSetter: mapGetterSetter{
+			"user_id":  1,
+			"group_id": 2,
+			"default":  true,
+		},
+	}
+
+	gm, err := unmarshalGroupMembership(m)
+	if err != nil {
+		t.Fatalf("Could not unmarshal map %v", err)
+	}
+
+	if gm.ID != 4 || gm.UserID != 1 || gm.GroupID != 2 || !gm.Default {
+		t.Fatalf("group membership had incorrect values %v", gm)
+	}
+}
+
+func TestCreateGroupMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"user_id":  1,
+			"group_id": 2,
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/group_memberships.json"), Any()).
+		Return([]byte(`{"group_membership":{"id":4,"user_id":1,"group_id":2,"default":true}}`), nil)
+	if diags := createGroupMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("create group membership returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "4" {
+		t.Fatalf("Create did not set resource id. Id was %s", v)
+	}
+	if v := i.Get("default"); v != true {
+		t.Fatalf("Create did not set default from response. default was %v", v)
+	}
+}
+
+func TestReadGroupMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("4")
+
+	m.EXPECT().Get(Any(), Eq("/group_memberships/4.json")).
+		Return([]byte(`{"group_membership":{"id":4,"user_id":1,"group_id":2,"default":false}}`), nil)
+	if diags := readGroupMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readGroupMembership returned an error: %v", diags)
+	}
+
+	if v := i.Get("user_id"); v != int64(1) {
+		t.Fatalf("user_id field %v does not have expected value 1", v)
+	}
+	if v := i.Get("group_id"); v != int64(2) {
+		t.Fatalf("group_id field %v does not have expected value 2", v)
+	}
+}
+
+func TestReadGroupMembershipNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("4")
+
+	m.EXPECT().Get(Any(), Eq("/group_memberships/4.json")).Return(nil, newNotFoundError())
+	if diags := readGroupMembership(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readGroupMembership returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readGroupMembership did not remove resource from state. Id was %s", v)
+	}
+}
+
+func TestUpdateGroupMembershipMakesDefault(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4",
+		mapGetterSetter: mapGetterSetter{
+			"user_id":  1,
+			"group_id": 2,
+			"default":  true,
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/users/1/group_memberships/4/make_default.json"), Any()).Return(nil, nil)
+	m.EXPECT().Get(Any(), Eq("/group_memberships/4.json")).
+		Return([]byte(`{"group_membership":{"id":4,"user_id":1,"group_id":2,"default":true}}`), nil)
+	if diags := updateGroupMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateGroupMembership returned an error: %v", diags)
+	}
+}
+
+func TestGroupMembershipUnsetDefaultHasNoDiff(t *testing.T) {
+	r := resourceZendeskGroupMembership()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"user_id":  1,
+		"group_id": 2,
+		"default":  true,
+	})
+	d.SetId("4")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id":  1,
+		"group_id": 2,
+		"default":  false,
+	})
+	diff, err := r.Diff(context.Background(), d.State(), config, nil)
+	if err != nil {
+		t.Fatalf("Diff returned an error: %v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("default = false should not plan an update. got %v", diff.Attributes)
+	}
+}
+
+func TestDeleteGroupMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/group_memberships/4.json")).Return(nil)
+	if diags := deleteGroupMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteGroupMembership returned an error: %v", diags)
+	}
+}
+
+func TestImportGroupMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+
+	m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=1")).
+		Return([]byte(`{"group_memberships":[{"id":3,"user_id":5,"group_id":2}],"next_page":"https://example.zendesk.com/api/v2/groups/2/memberships.json?page=2"}`), nil)
+	m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=2")).
+		Return([]byte(`{"group_memberships":[{"id":4,"user_id":1,"group_id":2}],"next_page":null}`), nil)
+
+	i.SetId("2:1")
+	if err := importGroupMembership(context.Background(), i, m); err != nil {
+		t.Fatalf("importGroupMembership returned an error: %v", err)
+	}
+	if v := i.Id(); v != "4" {
+		t.Fatalf("importGroupMembership resolved id %s. should have been 4", v)
+	}
+
+	i.SetId("2-1")
+	if err := importGroupMembership(context.Background(), i, m); err == nil {
+		t.Fatalf("importGroupMembership should return an error for malformed id")
+	}
+}
@@ -0,0 +1,174 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+func resourceZendeskGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides an authoritative set of agents of a group. Members not listed are removed from the group. " +
+			"Do not use together with `zendesk_group_membership` for the same group.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createGroupMemberships(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readGroupMemberships(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateGroupMemberships(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteGroupMemberships(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The id of a group.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_ids": {
+				Description: "The ids of all agents of the group. An empty set removes every member.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func unmarshalGroupMembershipUserIDs(d identifiableGetterSetter) map[int64]bool {
+	userIDs := make(map[int64]bool)
+
+	if v, ok := d.GetOk("user_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			userIDs[int64(id.(int))] = true
+		}
+	}
+
+	return userIDs
+}
+
+func createGroupMemberships(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%d", d.Get("group_id").(int)))
+	return updateGroupMemberships(ctx, d, zd)
+}
+
+func readGroupMemberships(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	memberships, err := fetchGroupMemberships(ctx, zd, groupID)
+	if isNotFound(err) {
+		return removeNotFound(d, "Group")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	userIDs := make([]int, 0, len(memberships))
+	for _, m := range memberships {
+		userIDs = append(userIDs, int(m.UserID))
+	}
+
+	err = setSchemaFields(d, map[string]interface{}{
+		"group_id": groupID,
+		"user_ids": userIDs,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// updateGroupMemberships reconciles the members of the group with user_ids
+func updateGroupMemberships(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	groupID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired := unmarshalGroupMembershipUserIDs(d)
+
+	memberships, err := fetchGroupMemberships(ctx, zd, groupID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	for _, m := range memberships {
+		if desired[m.UserID] {
+			delete(desired, m.UserID)
+			continue
+		}
+
+		err = zd.Delete(ctx, fmt.Sprintf("/group_memberships/%d.json", m.ID))
+		if err != nil && !isNotFound(err) {
+			return diagFromErr(err)
+		}
+	}
+
+	for userID := range desired {
+		_, err = postGroupMembership(ctx, zd, client.GroupMembership{
+			GroupID: groupID,
+			UserID:  userID,
+		})
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readGroupMemberships(ctx, d, zd)
+}
+
+func deleteGroupMemberships(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managed := unmarshalGroupMembershipUserIDs(d)
+
+	memberships, err := fetchGroupMemberships(ctx, zd, groupID)
+	if isNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	for _, m := range memberships {
+		if !managed[m.UserID] {
+			continue
+		}
+
+		err = zd.Delete(ctx, fmt.Sprintf("/group_memberships/%d.json", m.ID))
+		if err != nil && !isNotFound(err) {
+			return diagFromErr(err)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,110 @@
+package zendesk
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testGroupMembershipsPage = `{
+	"group_memberships": [
+		{"id": 10, "user_id": 1, "group_id": 2},
+		{"id": 11, "user_id": 3, "group_id": 2}
+	],
+	"next_page": null
+}`
+
+func TestReadGroupMemberships(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("2")
+
+	m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=1")).Return([]byte(testGroupMembershipsPage), nil)
+	if diags := readGroupMemberships(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readGroupMemberships returned an error: %v", diags)
+	}
+
+	userIDs := i.Get("user_ids").([]int)
+	sort.Ints(userIDs)
+	if len(userIDs) != 2 || userIDs[0] != 1 || userIDs[1] != 3 {
+		t.Fatalf("user_ids was %v. should have been [1 3]", userIDs)
+	}
+}
+
+func TestUpdateGroupMembershipsReconciles(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "2",
+		mapGetterSetter: mapGetterSetter{
+			"group_id": 2,
+			"user_ids": schema.NewSet(schema.HashInt, []interface{}{1, 5}),
+		},
+	}
+
+	InOrder(
+		m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=1")).Return([]byte(testGroupMembershipsPage), nil),
+		// user 3 is not managed and gets removed
+		m.EXPECT().Delete(Any(), Eq("/group_memberships/11.json")).Return(nil),
+		// user 5 is missing and gets added
+		m.EXPECT().Post(Any(), Eq("/group_memberships.json"), Any()).
+			Return([]byte(`{"group_membership":{"id":12,"user_id":5,"group_id":2}}`), nil),
+		m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=1")).
+			Return([]byte(`{"group_memberships":[{"id":10,"user_id":1,"group_id":2},{"id":12,"user_id":5,"group_id":2}],"next_page":null}`), nil),
+	)
+
+	if diags := updateGroupMemberships(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateGroupMemberships returned an error: %v", diags)
+	}
+}
+
+func TestCreateGroupMembershipsSetsID(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"group_id": 2,
+			"user_ids": schema.NewSet(schema.HashInt, []interface{}{1, 3}),
+		},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=1")).Return([]byte(testGroupMembershipsPage), nil).Times(2)
+	if diags := createGroupMemberships(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createGroupMemberships returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "2" {
+		t.Fatalf("Create did not set resource id to group id. Id was %s", v)
+	}
+}
+
+func TestDeleteGroupMembershipsOnlyRemovesManaged(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "2",
+		mapGetterSetter: mapGetterSetter{
+			"group_id": 2,
+			"user_ids": schema.NewSet(schema.HashInt, []interface{}{1}),
+		},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/groups/2/memberships.json?page=1")).Return([]byte(testGroupMembershipsPage), nil)
+	m.EXPECT().Delete(Any(), Eq("/group_memberships/10.json")).Return(nil)
+	if diags := deleteGroupMemberships(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteGroupMemberships returned an error: %v", diags)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -101,6 +102,27 @@ func TestReadGroup(t *testing.T) {
 	}
 }
 
+func TestReadGroupNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetGroup(Any(), Eq(int64(12345))).Return(zendesk.Group{}, newNotFoundError())
+	diags := readGroup(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readGroup returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readGroup should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readGroup did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestCreateGroup(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,398 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type helpCenterArticle struct {
+	ID                int64    `json:"id,omitempty"`
+	URL               string   `json:"url,omitempty"`
+	HTMLURL           string   `json:"html_url,omitempty"`
+	SectionID         int64    `json:"section_id,omitempty"`
+	Title             string   `json:"title"`
+	Body              string   `json:"body"`
+	Locale            string   `json:"locale"`
+	PermissionGroupID int64    `json:"permission_group_id"`
+	UserSegmentID     *int64   `json:"user_segment_id"`
+	LabelNames        []string `json:"label_names"`
+	Draft             bool     `json:"draft"`
+	Promoted          bool     `json:"promoted"`
+	Position          *int64   `json:"position,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+func resourceZendeskHelpCenterArticle(brands *helpCenterBrands) *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center article resource. Deleting an article archives it.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return createHelpCenterArticle(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return readHelpCenterArticle(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return updateHelpCenterArticle(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return deleteHelpCenterArticle(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := importHelpCenterResource(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			path := d.Get("body_file").(string)
+			if path == "" {
+				return nil
+			}
+
+			if !d.GetRawConfig().GetAttr("body").IsNull() {
+				return fmt.Errorf("only one of body and body_file can be set")
+			}
+
+			body, err := readHelpCenterArticleBody(path)
+			if err != nil {
+				return err
+			}
+
+			if d.Get("body").(string) != body {
+				return d.SetNew("body", body)
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"brand_id": helpCenterBrandSchema("article"),
+			"url":      helpCenterURLSchema("article"),
+			"html_url": helpCenterHTMLURLSchema("article"),
+			"locale":   helpCenterLocaleSchema("article"),
+			"section_id": {
+				Description: "The id of the section the article belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"title": {
+				Description: "The title of the article in the source locale.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"body": {
+				Description: "The HTML body of the article in the source locale. Conflicts with `body_file`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"body_file": {
+				Description: "The path of a file containing the HTML body of the article in the source locale. Changes to the file content are detected on plan. Conflicts with `body`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"permission_group_id": {
+				Description: "The id of the permission group which defines who can edit and publish the article.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"user_segment_id": {
+				Description: "The id of the user segment which defines who can see the article. Everyone can see the article when unset.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"label_names": {
+				Description: "The labels of the article.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"draft": {
+				Description: "Whether the article in the source locale is a draft.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"promoted": {
+				Description: "Whether the article is promoted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"position": {
+				Description: "The position of the article relative to other articles in the section.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"translation": helpCenterTranslationSchema("article", true),
+		},
+	}
+}
+
+func readHelpCenterArticleBody(path string) (string, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read body_file %s: %v", path, err)
+	}
+	return string(body), nil
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalHelpCenterArticle(article helpCenterArticle, translations []helpCenterTranslation, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":                 article.URL,
+		"html_url":            article.HTMLURL,
+		"locale":              article.Locale,
+		"section_id":          article.SectionID,
+		"title":               article.Title,
+		"body":                article.Body,
+		"permission_group_id": article.PermissionGroupID,
+		"user_segment_id":     nil,
+		"label_names":         article.LabelNames,
+		"draft":               article.Draft,
+		"promoted":            article.Promoted,
+		"position":            nil,
+		"translation":         marshalHelpCenterTranslations(translations, article.Locale, true),
+	}
+
+	if article.UserSegmentID != nil {
+		fields["user_segment_id"] = *article.UserSegmentID
+	}
+
+	if article.Position != nil {
+		fields["position"] = *article.Position
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalHelpCenterArticle(d identifiableGetterSetter) (helpCenterArticle, []helpCenterTranslation, error) {
+	article := helpCenterArticle{
+		LabelNames: []string{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return article, nil, fmt.Errorf("could not parse article id %s: %v", v, err)
+		}
+		article.ID = id
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		article.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("section_id"); ok {
+		article.SectionID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		article.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("body_file"); ok {
+		body, err := readHelpCenterArticleBody(v.(string))
+		if err != nil {
+			return article, nil, err
+		}
+		article.Body = body
+	} else if v, ok := d.GetOk("body"); ok {
+		article.Body = v.(string)
+	}
+
+	if v, ok := d.GetOk("permission_group_id"); ok {
+		article.PermissionGroupID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("user_segment_id"); ok {
+		userSegmentID := int64(v.(int))
+		article.UserSegmentID = &userSegmentID
+	}
+
+	if v, ok := d.GetOk("label_names"); ok {
+		for _, label := range v.(*schema.Set).List() {
+			article.LabelNames = append(article.LabelNames, label.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("draft"); ok {
+		article.Draft = v.(bool)
+	}
+
+	if v, ok := d.GetOk("promoted"); ok {
+		article.Promoted = v.(bool)
+	}
+
+	article.Position = helpCenterPosition(d)
+
+	draft := article.Draft
+	source := helpCenterTranslation{
+		Locale: article.Locale,
+		Title:  article.Title,
+		Body:   article.Body,
+		Draft:  &draft,
+	}
+	translations, err := unmarshalHelpCenterTranslations(d, source)
+	if err != nil {
+		return article, nil, err
+	}
+
+	return article, translations, nil
+}
+
+func createHelpCenterArticle(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	article, translations, err := unmarshalHelpCenterArticle(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	path := fmt.Sprintf("/help_center/%s/sections/%d/articles.json", article.Locale, article.SectionID)
+	body, err := zd.Post(ctx, path, map[string]interface{}{"article": article})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	created, err := decodeHelpCenterArticle(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", created.ID))
+
+	err = syncHelpCenterTranslations(ctx, zd, "articles", created.ID, translations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readHelpCenterArticle(ctx, d, zd)
+}
+
+func readHelpCenterArticle(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/help_center/articles/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Help Center article")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	article, err := decodeHelpCenterArticle(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	translations, err := fetchHelpCenterTranslations(ctx, zd, "articles", id)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The article is returned in the default locale of the Help Center
+	article.Locale = sourceLocale(d, article.Locale)
+	for _, t := range translations {
+		if t.Locale == article.Locale {
+			article.Title = t.Title
+			article.Body = t.Body
+			article.Draft = t.Draft != nil && *t.Draft
+		}
+	}
+
+	err = marshalHelpCenterArticle(article, translations, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterArticle(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	article, translations, err := unmarshalHelpCenterArticle(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	// Only metadata is updated on the article, the content is part of the translations
+	payload := map[string]interface{}{
+		"section_id":          article.SectionID,
+		"permission_group_id": article.PermissionGroupID,
+		"user_segment_id":     article.UserSegmentID,
+		"label_names":         article.LabelNames,
+		"promoted":            article.Promoted,
+	}
+	if article.Position != nil {
+		payload["position"] = *article.Position
+	}
+	_, err = zd.Put(ctx, fmt.Sprintf("/help_center/articles/%d.json", article.ID), map[string]interface{}{"article": payload})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = syncHelpCenterTranslations(ctx, zd, "articles", article.ID, translations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readHelpCenterArticle(ctx, d, zd)
+}
+
+func deleteHelpCenterArticle(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/articles/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeHelpCenterArticle(body []byte) (helpCenterArticle, error) {
+	var result struct {
+		Article helpCenterArticle `json:"article"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Article, err
+}
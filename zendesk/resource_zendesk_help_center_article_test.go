@@ -0,0 +1,124 @@
+package zendesk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalHelpCenterArticleBodyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "article.html")
+	if err := os.WriteFile(path, []byte("<p>Hello</p>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"locale":              "en-us",
+			"section_id":          56,
+			"title":               "Getting started",
+			"body_file":           path,
+			"permission_group_id": 78,
+			"label_names":         schema.NewSet(schema.HashString, []interface{}{"onboarding"}),
+			"draft":               true,
+			"translation": testHelpCenterTranslations("article", true,
+				map[string]interface{}{"locale": "ja", "title": "はじめに", "body": "", "draft": false},
+			),
+		},
+	}
+
+	article, translations, err := unmarshalHelpCenterArticle(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if article.Body != "<p>Hello</p>" {
+		t.Fatalf("article body was not read from body_file. got %s", article.Body)
+	}
+	if article.UserSegmentID != nil {
+		t.Fatalf("article should not have a user segment. got %d", *article.UserSegmentID)
+	}
+	if len(article.LabelNames) != 1 || article.LabelNames[0] != "onboarding" {
+		t.Fatalf("article had incorrect label names %v", article.LabelNames)
+	}
+	if len(translations) != 2 || translations[0].Draft == nil || !*translations[0].Draft {
+		t.Fatalf("source translation should be a draft. got %v", translations)
+	}
+	if translations[1].Draft == nil || *translations[1].Draft {
+		t.Fatalf("ja translation should be published. got %v", translations[1])
+	}
+}
+
+func TestCreateHelpCenterArticle(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"locale":              "en-us",
+			"section_id":          56,
+			"title":               "Getting started",
+			"body":                "<p>Hello</p>",
+			"permission_group_id": 78,
+			"user_segment_id":     90,
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/help_center/en-us/sections/56/articles.json"), Any()).
+		Return([]byte(`{"article":{"id":1234}}`), nil)
+	m.EXPECT().Get(Any(), Eq("/help_center/articles/1234/translations.json?page=1")).
+		Return([]byte(`{"translations":[{"id":11,"locale":"en-us","title":"Getting started","body":"<p>Hello</p>","draft":false}],"next_page":null}`), nil).
+		Times(2)
+	m.EXPECT().Get(Any(), Eq("/help_center/articles/1234.json")).
+		Return([]byte(`{"article":{"id":1234,"locale":"en-us","section_id":56,"title":"Getting started","permission_group_id":78,"user_segment_id":90,"label_names":[]}}`), nil)
+
+	if diags := createHelpCenterArticle(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterArticle returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createHelpCenterArticle did not set resource id. Id was %s", v)
+	}
+	if v := i.Get("user_segment_id"); v != int64(90) {
+		t.Fatalf("createHelpCenterArticle set user_segment_id %v. should have been 90", v)
+	}
+}
+
+func TestReadHelpCenterArticleNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/help_center/articles/1234.json")).Return(nil, newNotFoundError())
+	if diags := readHelpCenterArticle(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readHelpCenterArticle returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readHelpCenterArticle did not remove article from state. Id was %s", v)
+	}
+}
+
+func TestDeleteHelpCenterArticle(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/help_center/articles/1234.json")).Return(nil)
+	if diags := deleteHelpCenterArticle(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterArticle returned an error: %v", diags)
+	}
+}
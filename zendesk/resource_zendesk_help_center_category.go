@@ -0,0 +1,613 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type helpCenterCategory struct {
+	ID          int64  `json:"id,omitempty"`
+	URL         string `json:"url,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Locale      string `json:"locale"`
+	Position    *int64 `json:"position,omitempty"`
+}
+
+// helpCenterTranslation is the content of a category, section or article in one locale
+type helpCenterTranslation struct {
+	ID     int64  `json:"id,omitempty"`
+	Locale string `json:"locale"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	Draft  *bool  `json:"draft,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/
+func resourceZendeskHelpCenterCategory(brands *helpCenterBrands) *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center category resource. Deleting a category also deletes all of its sections and articles.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return createHelpCenterCategory(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return readHelpCenterCategory(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return updateHelpCenterCategory(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return deleteHelpCenterCategory(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := importHelpCenterResource(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"brand_id": helpCenterBrandSchema("category"),
+			"url":      helpCenterURLSchema("category"),
+			"html_url": helpCenterHTMLURLSchema("category"),
+			"locale":   helpCenterLocaleSchema("category"),
+			"name": {
+				Description: "The name of the category in the source locale.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the category in the source locale.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"position": {
+				Description: "The position of the category relative to other categories.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"translation": helpCenterTranslationSchema("category", false),
+		},
+	}
+}
+
+func helpCenterBrandSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The id of the brand whose Help Center hosts the %s. Defaults to the brand of the account the provider is configured with. Can not be used when the provider is configured with `api_url`.", kind),
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+	}
+}
+
+func helpCenterURLSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The API url of the %s.", kind),
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+}
+
+func helpCenterHTMLURLSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The url of the %s in Help Center.", kind),
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+}
+
+func helpCenterLocaleSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The source locale of the %s, e.g. `en-us`. Changing the locale forces a new %s.", kind, kind),
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+}
+
+func helpCenterTranslationSchema(kind string, withDraft bool) *schema.Schema {
+	elem := map[string]*schema.Schema{
+		"locale": {
+			Description: "The locale of the translation. Must differ from the source locale.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"title": {
+			Description: fmt.Sprintf("The title of the %s in this locale.", kind),
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"body": {
+			Description: fmt.Sprintf("The body of the %s in this locale.", kind),
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+
+	if withDraft {
+		elem["draft"] = &schema.Schema{
+			Description: "Whether the translation is a draft.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		}
+	}
+
+	return &schema.Schema{
+		Description: fmt.Sprintf("A translation of the %s into another locale of the Help Center.", kind),
+		Type:        schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: elem,
+		},
+		Optional: true,
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalHelpCenterCategory(category helpCenterCategory, translations []helpCenterTranslation, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":         category.URL,
+		"html_url":    category.HTMLURL,
+		"locale":      category.Locale,
+		"name":        category.Name,
+		"description": category.Description,
+		"position":    nil,
+		"translation": marshalHelpCenterTranslations(translations, category.Locale, false),
+	}
+
+	if category.Position != nil {
+		fields["position"] = *category.Position
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalHelpCenterCategory(d identifiableGetterSetter) (helpCenterCategory, []helpCenterTranslation, error) {
+	category := helpCenterCategory{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return category, nil, fmt.Errorf("could not parse category id %s: %v", v, err)
+		}
+		category.ID = id
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		category.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		category.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		category.Description = v.(string)
+	}
+
+	category.Position = helpCenterPosition(d)
+
+	source := helpCenterTranslation{
+		Locale: category.Locale,
+		Title:  category.Name,
+		Body:   category.Description,
+	}
+	translations, err := unmarshalHelpCenterTranslations(d, source)
+	if err != nil {
+		return category, nil, err
+	}
+
+	return category, translations, nil
+}
+
+func createHelpCenterCategory(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	category, translations, err := unmarshalHelpCenterCategory(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"category": category,
+	}
+	body, err := zd.Post(ctx, fmt.Sprintf("/help_center/%s/categories.json", category.Locale), payload)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	created, err := decodeHelpCenterCategory(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", created.ID))
+
+	err = syncHelpCenterTranslations(ctx, zd, "categories", created.ID, translations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readHelpCenterCategory(ctx, d, zd)
+}
+
+func readHelpCenterCategory(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/help_center/categories/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Help Center category")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	category, err := decodeHelpCenterCategory(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	translations, err := fetchHelpCenterTranslations(ctx, zd, "categories", id)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The category is returned in the default locale of the Help Center
+	category.Locale = sourceLocale(d, category.Locale)
+	for _, t := range translations {
+		if t.Locale == category.Locale {
+			category.Name = t.Title
+			category.Description = t.Body
+		}
+	}
+
+	err = marshalHelpCenterCategory(category, translations, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterCategory(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	category, translations, err := unmarshalHelpCenterCategory(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	if category.Position != nil {
+		payload := map[string]interface{}{
+			"category": map[string]interface{}{
+				"position": *category.Position,
+			},
+		}
+		_, err = zd.Put(ctx, fmt.Sprintf("/help_center/categories/%d.json", category.ID), payload)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	err = syncHelpCenterTranslations(ctx, zd, "categories", category.ID, translations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readHelpCenterCategory(ctx, d, zd)
+}
+
+func deleteHelpCenterCategory(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/categories/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeHelpCenterCategory(body []byte) (helpCenterCategory, error) {
+	var result struct {
+		Category helpCenterCategory `json:"category"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Category, err
+}
+
+// helpCenterBrands resolves the Help Center API endpoint of each brand.
+// It belongs to a provider instance, so that refreshing many Help Center
+// resources only looks up each brand once per configured provider.
+type helpCenterBrands struct {
+	mu        sync.Mutex
+	apiURL    bool
+	endpoints map[int64]string
+}
+
+// configure forgets the endpoints looked up with a previous configuration
+func (b *helpCenterBrands) configure(apiURL bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.apiURL = apiURL
+	b.endpoints = map[int64]string{}
+}
+
+func (b *helpCenterBrands) endpoint(ctx context.Context, zd *client.Client, brandID int64) (string, error) {
+	b.mu.Lock()
+	endpoint, ok := b.endpoints[brandID]
+	b.mu.Unlock()
+	if ok {
+		return endpoint, nil
+	}
+
+	brand, err := zd.GetBrand(ctx, brandID)
+	if err != nil {
+		return "", err
+	}
+	if brand.BrandURL == "" {
+		return "", fmt.Errorf("brand %d has no url", brand.ID)
+	}
+	endpoint = strings.TrimSuffix(brand.BrandURL, "/") + "/api/v2"
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.endpoints == nil {
+		b.endpoints = map[int64]string{}
+	}
+	b.endpoints[brandID] = endpoint
+	return endpoint, nil
+}
+
+// helpCenterClient returns a client for the Help Center of the brand in
+// brand_id since Help Center APIs are served from the host of each brand
+func (b *helpCenterBrands) helpCenterClient(ctx context.Context, d getter, zd *client.Client) (client.BaseAPI, error) {
+	v, ok := d.GetOk("brand_id")
+	if !ok {
+		return zd, nil
+	}
+
+	// Requests to api_url must not be redirected to the host of the brand
+	if b.apiURL {
+		return nil, fmt.Errorf("brand_id can not be used with api_url since Help Center requests are sent to the host of the brand. configure account instead")
+	}
+
+	endpoint, err := b.endpoint(ctx, zd, int64(v.(int)))
+	if err != nil {
+		return nil, err
+	}
+
+	// The copy shares the HTTP client and credential of the provider
+	brandClient := *zd
+	err = brandClient.SetEndpointURL(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &brandClient, nil
+}
+
+// importHelpCenterResource accepts either the id or brand_id:id of a
+// category, section or article
+func importHelpCenterResource(d identifiableGetterSetter) error {
+	if _, err := atoi64(d.Id()); err == nil {
+		return nil
+	}
+
+	ids, err := parseCompositeID(d.Id(), "brand_id", "id")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", ids[1]))
+	return d.Set("brand_id", int(ids[0]))
+}
+
+// helpCenterPosition returns the position set in the configuration, or nil
+// to leave it to Zendesk. 0 is a valid position, so GetOk can't tell it apart.
+func helpCenterPosition(d getter) *int64 {
+	if c, ok := d.(interface{ GetRawConfig() cty.Value }); ok {
+		if config := c.GetRawConfig(); !config.IsNull() {
+			v := config.GetAttr("position")
+			if v.IsNull() || !v.IsKnown() {
+				return nil
+			}
+			position, _ := v.AsBigFloat().Int64()
+			return &position
+		}
+	}
+
+	// Test doubles only hold configured values
+	if v, ok := d.GetOk("position"); ok {
+		position := int64(v.(int))
+		return &position
+	}
+
+	return nil
+}
+
+// sourceLocale prefers the configured locale over the one returned by the API
+func sourceLocale(d getter, locale string) string {
+	if v, ok := d.GetOk("locale"); ok {
+		return v.(string)
+	}
+	return locale
+}
+
+// marshalHelpCenterTranslations leaves out the source locale, which is
+// managed by the top level attributes
+func marshalHelpCenterTranslations(translations []helpCenterTranslation, source string, withDraft bool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(translations))
+	for _, t := range translations {
+		if t.Locale == source {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"locale": t.Locale,
+			"title":  t.Title,
+			"body":   t.Body,
+		}
+		if withDraft {
+			m["draft"] = t.Draft != nil && *t.Draft
+		}
+		result = append(result, m)
+	}
+
+	return result
+}
+
+// unmarshalHelpCenterTranslations returns the translation blocks along with
+// the source translation
+func unmarshalHelpCenterTranslations(d getter, source helpCenterTranslation) ([]helpCenterTranslation, error) {
+	translations := []helpCenterTranslation{source}
+
+	v, ok := d.GetOk("translation")
+	if !ok {
+		return translations, nil
+	}
+
+	for _, e := range v.(*schema.Set).List() {
+		translation, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("could not parse translation %v", e)
+		}
+
+		t := helpCenterTranslation{
+			Locale: translation["locale"].(string),
+			Title:  translation["title"].(string),
+		}
+		if body, ok := translation["body"].(string); ok {
+			t.Body = body
+		}
+		if draft, ok := translation["draft"].(bool); ok {
+			t.Draft = &draft
+		}
+
+		if t.Locale == source.Locale {
+			return nil, fmt.Errorf("translation locale %s is the source locale. set the top level attributes instead", t.Locale)
+		}
+		translations = append(translations, t)
+	}
+
+	return translations, nil
+}
+
+func fetchHelpCenterTranslations(ctx context.Context, zd client.BaseAPI, kind string, id int64) ([]helpCenterTranslation, error) {
+	var translations []helpCenterTranslation
+
+	for page := 1; ; page++ {
+		var result struct {
+			Translations []helpCenterTranslation `json:"translations"`
+			NextPage     *string                 `json:"next_page"`
+		}
+
+		body, err := zd.Get(ctx, fmt.Sprintf("/help_center/%s/%d/translations.json?page=%d", kind, id, page))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+
+		translations = append(translations, result.Translations...)
+		if result.NextPage == nil || len(result.Translations) == 0 {
+			return translations, nil
+		}
+	}
+}
+
+// syncHelpCenterTranslations creates, updates and deletes translations by
+// locale so that they match the desired ones
+func syncHelpCenterTranslations(ctx context.Context, zd client.BaseAPI, kind string, id int64, desired []helpCenterTranslation) error {
+	existing, err := fetchHelpCenterTranslations(ctx, zd, kind, id)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]helpCenterTranslation)
+	for _, t := range existing {
+		current[t.Locale] = t
+	}
+
+	wanted := make(map[string]bool)
+	for _, t := range desired {
+		wanted[t.Locale] = true
+
+		payload := map[string]interface{}{
+			"translation": t,
+		}
+
+		c, ok := current[t.Locale]
+		if !ok {
+			_, err := zd.Post(ctx, fmt.Sprintf("/help_center/%s/%d/translations.json", kind, id), payload)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if c.Title == t.Title && c.Body == t.Body && (t.Draft == nil || c.Draft != nil && *c.Draft == *t.Draft) {
+			continue
+		}
+
+		_, err := zd.Put(ctx, fmt.Sprintf("/help_center/%s/%d/translations/%s.json", kind, id, t.Locale), payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, t := range existing {
+		if wanted[t.Locale] {
+			continue
+		}
+
+		err := zd.Delete(ctx, fmt.Sprintf("/help_center/translations/%d.json", t.ID))
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testHelpCenterCategoryTranslationsResponse = `{
+  "translations": [
+    {"id": 11, "locale": "en-us", "title": "Billing", "body": "Invoices and payments", "draft": false},
+    {"id": 12, "locale": "ja", "title": "請求", "body": "", "draft": false}
+  ],
+  "next_page": null
+}`
+
+func testHelpCenterTranslations(kind string, withDraft bool, translations ...map[string]interface{}) *schema.Set {
+	elem := helpCenterTranslationSchema(kind, withDraft).Elem.(*schema.Resource)
+	list := make([]interface{}, 0, len(translations))
+	for _, v := range translations {
+		list = append(list, v)
+	}
+	return schema.NewSet(schema.HashResource(elem), list)
+}
+
+func TestUnmarshalHelpCenterCategory(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"locale":      "en-us",
+			"name":        "Billing",
+			"description": "Invoices and payments",
+			"translation": testHelpCenterTranslations("category", false,
+				map[string]interface{}{"locale": "ja", "title": "請求", "body": ""},
+			),
+		},
+	}
+
+	category, translations, err := unmarshalHelpCenterCategory(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if category.ID != 1234 || category.Name != "Billing" {
+		t.Fatalf("category had incorrect values %v", category)
+	}
+	if len(translations) != 2 || translations[0].Locale != "en-us" || translations[0].Title != "Billing" || translations[1].Locale != "ja" {
+		t.Fatalf("category had incorrect translations %v", translations)
+	}
+	if translations[1].Draft != nil {
+		t.Fatalf("category translations should not have draft. got %v", *translations[1].Draft)
+	}
+}
+
+func TestUnmarshalHelpCenterCategorySourceLocaleTranslation(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"locale": "en-us",
+			"name":   "Billing",
+			"translation": testHelpCenterTranslations("category", false,
+				map[string]interface{}{"locale": "en-us", "title": "Billing", "body": ""},
+			),
+		},
+	}
+
+	if _, _, err := unmarshalHelpCenterCategory(m); err == nil {
+		t.Fatalf("unmarshal should return an error for a translation in the source locale")
+	}
+}
+
+func TestCreateHelpCenterCategory(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"locale":      "en-us",
+			"name":        "Billing",
+			"description": "Invoices and payments",
+			"translation": testHelpCenterTranslations("category", false,
+				map[string]interface{}{"locale": "ja", "title": "請求", "body": ""},
+			),
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/help_center/en-us/categories.json"), Any()).
+		Return([]byte(`{"category":{"id":1234,"locale":"en-us","name":"Billing"}}`), nil)
+	InOrder(
+		m.EXPECT().Get(Any(), Eq("/help_center/categories/1234/translations.json?page=1")).
+			Return([]byte(`{"translations":[{"id":11,"locale":"en-us","title":"Billing","body":"Invoices and payments"}],"next_page":null}`), nil),
+		m.EXPECT().Get(Any(), Eq("/help_center/categories/1234/translations.json?page=1")).
+			Return([]byte(testHelpCenterCategoryTranslationsResponse), nil),
+	)
+	m.EXPECT().Post(Any(), Eq("/help_center/categories/1234/translations.json"), Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Get(Any(), Eq("/help_center/categories/1234.json")).
+		Return([]byte(`{"category":{"id":1234,"locale":"en-us","name":"Billing","position":0}}`), nil)
+
+	if diags := createHelpCenterCategory(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterCategory returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createHelpCenterCategory did not set resource id. Id was %s", v)
+	}
+	translations := i.Get("translation").([]map[string]interface{})
+	if len(translations) != 1 || translations[0]["locale"] != "ja" {
+		t.Fatalf("category had incorrect translations %v", translations)
+	}
+}
+
+func TestReadHelpCenterCategoryNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/help_center/categories/1234.json")).Return(nil, newNotFoundError())
+	if diags := readHelpCenterCategory(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readHelpCenterCategory returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readHelpCenterCategory did not remove category from state. Id was %s", v)
+	}
+}
+
+func TestSyncHelpCenterTranslations(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	desired := []helpCenterTranslation{
+		{Locale: "en-us", Title: "Billing and payments", Body: "Invoices and payments"},
+		{Locale: "de", Title: "Abrechnung"},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/help_center/categories/1234/translations.json?page=1")).
+		Return([]byte(testHelpCenterCategoryTranslationsResponse), nil)
+	m.EXPECT().Put(Any(), Eq("/help_center/categories/1234/translations/en-us.json"), Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Post(Any(), Eq("/help_center/categories/1234/translations.json"), Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Delete(Any(), Eq("/help_center/translations/12.json")).Return(nil)
+
+	if err := syncHelpCenterTranslations(context.Background(), m, "categories", 1234, desired); err != nil {
+		t.Fatalf("syncHelpCenterTranslations returned an error: %v", err)
+	}
+}
+
+func TestDeleteHelpCenterCategory(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/help_center/categories/1234.json")).Return(nil)
+	if diags := deleteHelpCenterCategory(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterCategory returned an error: %v", diags)
+	}
+}
+
+func TestImportHelpCenterResource(t *testing.T) {
+	i := &identifiableMapGetterSetter{
+		id:              "56:1234",
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	if err := importHelpCenterResource(i); err != nil {
+		t.Fatalf("importHelpCenterResource returned an error: %v", err)
+	}
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("importHelpCenterResource set id %s. should have been 1234", v)
+	}
+	if v := i.Get("brand_id"); v != 56 {
+		t.Fatalf("importHelpCenterResource set brand_id %v. should have been 56", v)
+	}
+}
+
+func TestHelpCenterClientCachesBrandEndpoint(t *testing.T) {
+	var brandRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&brandRequests, 1)
+		_, _ = w.Write([]byte(`{"brand":{"id":56,"brand_url":"https://support.example.com/"}}`))
+	}))
+	defer server.Close()
+
+	zd, _ := client.NewClient(nil)
+	if err := zd.SetEndpointURL(server.URL + "/api/v2"); err != nil {
+		t.Fatal(err)
+	}
+	zd.SetCredential(client.NewAPITokenCredential("john.doe@example.com", "xxxx"))
+
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{"brand_id": 56},
+	}
+	brands := &helpCenterBrands{}
+	for i := 0; i < 3; i++ {
+		if _, err := brands.helpCenterClient(context.Background(), d, zd); err != nil {
+			t.Fatalf("helpCenterClient returned an error: %v", err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&brandRequests); n != 1 {
+		t.Fatalf("brand should have been looked up once. got %d requests", n)
+	}
+
+	// Reconfiguring the provider looks brands up again
+	brands.configure(false)
+	if _, err := brands.helpCenterClient(context.Background(), d, zd); err != nil {
+		t.Fatalf("helpCenterClient returned an error: %v", err)
+	}
+	if n := atomic.LoadInt32(&brandRequests); n != 2 {
+		t.Fatalf("brand should have been looked up again after configure. got %d requests", n)
+	}
+}
+
+func TestHelpCenterClientWithAPIURL(t *testing.T) {
+	zd, _ := client.NewClient(nil)
+	brands := &helpCenterBrands{}
+	brands.configure(true)
+
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+	v, err := brands.helpCenterClient(context.Background(), d, zd)
+	if err != nil {
+		t.Fatalf("helpCenterClient returned an error without brand_id: %v", err)
+	}
+	if v != zd {
+		t.Fatalf("helpCenterClient should use the api_url client without brand_id")
+	}
+
+	d.Set("brand_id", 56)
+	if _, err := brands.helpCenterClient(context.Background(), d, zd); err == nil || !strings.Contains(err.Error(), "api_url") {
+		t.Fatalf("helpCenterClient should refuse brand_id with api_url. got %v", err)
+	}
+}
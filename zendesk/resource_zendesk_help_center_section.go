@@ -0,0 +1,297 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type helpCenterSection struct {
+	ID              int64  `json:"id,omitempty"`
+	URL             string `json:"url,omitempty"`
+	HTMLURL         string `json:"html_url,omitempty"`
+	CategoryID      int64  `json:"category_id"`
+	ParentSectionID *int64 `json:"parent_section_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Locale          string `json:"locale"`
+	Position        *int64 `json:"position,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/
+func resourceZendeskHelpCenterSection(brands *helpCenterBrands) *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center section resource. Deleting a section also deletes all of its articles.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return createHelpCenterSection(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return readHelpCenterSection(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return updateHelpCenterSection(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd, err := brands.helpCenterClient(ctx, d, meta.(*client.Client))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			return deleteHelpCenterSection(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := importHelpCenterResource(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"brand_id": helpCenterBrandSchema("section"),
+			"url":      helpCenterURLSchema("section"),
+			"html_url": helpCenterHTMLURLSchema("section"),
+			"locale":   helpCenterLocaleSchema("section"),
+			"category_id": {
+				Description: "The id of the category the section belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"parent_section_id": {
+				Description: "The id of the parent section, for sections nested in another section.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"name": {
+				Description: "The name of the section in the source locale.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the section in the source locale.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"position": {
+				Description: "The position of the section relative to other sections in the category.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"translation": helpCenterTranslationSchema("section", false),
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalHelpCenterSection(section helpCenterSection, translations []helpCenterTranslation, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":               section.URL,
+		"html_url":          section.HTMLURL,
+		"locale":            section.Locale,
+		"category_id":       section.CategoryID,
+		"parent_section_id": nil,
+		"name":              section.Name,
+		"description":       section.Description,
+		"position":          nil,
+		"translation":       marshalHelpCenterTranslations(translations, section.Locale, false),
+	}
+
+	if section.ParentSectionID != nil {
+		fields["parent_section_id"] = *section.ParentSectionID
+	}
+
+	if section.Position != nil {
+		fields["position"] = *section.Position
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalHelpCenterSection(d identifiableGetterSetter) (helpCenterSection, []helpCenterTranslation, error) {
+	section := helpCenterSection{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return section, nil, fmt.Errorf("could not parse section id %s: %v", v, err)
+		}
+		section.ID = id
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		section.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("category_id"); ok {
+		section.CategoryID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("parent_section_id"); ok {
+		parentSectionID := int64(v.(int))
+		section.ParentSectionID = &parentSectionID
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		section.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		section.Description = v.(string)
+	}
+
+	section.Position = helpCenterPosition(d)
+
+	source := helpCenterTranslation{
+		Locale: section.Locale,
+		Title:  section.Name,
+		Body:   section.Description,
+	}
+	translations, err := unmarshalHelpCenterTranslations(d, source)
+	if err != nil {
+		return section, nil, err
+	}
+
+	return section, translations, nil
+}
+
+func createHelpCenterSection(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	section, translations, err := unmarshalHelpCenterSection(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	path := fmt.Sprintf("/help_center/%s/categories/%d/sections.json", section.Locale, section.CategoryID)
+	body, err := zd.Post(ctx, path, map[string]interface{}{"section": section})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	created, err := decodeHelpCenterSection(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", created.ID))
+
+	err = syncHelpCenterTranslations(ctx, zd, "sections", created.ID, translations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readHelpCenterSection(ctx, d, zd)
+}
+
+func readHelpCenterSection(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/help_center/sections/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Help Center section")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	section, err := decodeHelpCenterSection(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	translations, err := fetchHelpCenterTranslations(ctx, zd, "sections", id)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The section is returned in the default locale of the Help Center
+	section.Locale = sourceLocale(d, section.Locale)
+	for _, t := range translations {
+		if t.Locale == section.Locale {
+			section.Name = t.Title
+			section.Description = t.Body
+		}
+	}
+
+	err = marshalHelpCenterSection(section, translations, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterSection(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	section, translations, err := unmarshalHelpCenterSection(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"category_id":       section.CategoryID,
+		"parent_section_id": section.ParentSectionID,
+	}
+	if section.Position != nil {
+		payload["position"] = *section.Position
+	}
+	_, err = zd.Put(ctx, fmt.Sprintf("/help_center/sections/%d.json", section.ID), map[string]interface{}{"section": payload})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = syncHelpCenterTranslations(ctx, zd, "sections", section.ID, translations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readHelpCenterSection(ctx, d, zd)
+}
+
+func deleteHelpCenterSection(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/sections/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeHelpCenterSection(body []byte) (helpCenterSection, error) {
+	var result struct {
+		Section helpCenterSection `json:"section"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Section, err
+}
@@ -0,0 +1,174 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalHelpCenterSection(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"locale":            "en-us",
+			"category_id":       56,
+			"parent_section_id": 78,
+			"name":              "Invoices",
+		},
+	}
+
+	section, translations, err := unmarshalHelpCenterSection(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if section.ID != 1234 || section.CategoryID != 56 || section.Name != "Invoices" {
+		t.Fatalf("section had incorrect values %v", section)
+	}
+	if section.ParentSectionID == nil || *section.ParentSectionID != 78 {
+		t.Fatalf("section had incorrect parent section id %v", section.ParentSectionID)
+	}
+	if len(translations) != 1 || translations[0].Title != "Invoices" {
+		t.Fatalf("section had incorrect translations %v", translations)
+	}
+}
+
+func TestReadHelpCenterSection(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/help_center/sections/1234.json")).
+		Return([]byte(`{"section":{"id":1234,"locale":"en-us","category_id":56,"parent_section_id":null,"name":"Invoices","position":2}}`), nil)
+	m.EXPECT().Get(Any(), Eq("/help_center/sections/1234/translations.json?page=1")).
+		Return([]byte(`{"translations":[{"id":11,"locale":"en-us","title":"Invoices","body":"All about invoices"}],"next_page":null}`), nil)
+
+	if diags := readHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterSection returned an error: %v", diags)
+	}
+
+	if v := i.Get("parent_section_id"); v != nil {
+		t.Fatalf("readHelpCenterSection set parent_section_id %v. should have been nil", v)
+	}
+	if v := i.Get("description"); v != "All about invoices" {
+		t.Fatalf("readHelpCenterSection set description %v. should have been the source translation body", v)
+	}
+}
+
+func TestUpdateHelpCenterSectionUnsetParent(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"locale":      "en-us",
+			"category_id": 56,
+			"name":        "Invoices",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/help_center/sections/1234.json"), Any()).
+		DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+			payload := data.(map[string]interface{})["section"].(map[string]interface{})
+			if v := payload["parent_section_id"].(*int64); v != nil {
+				t.Fatalf("update should unset parent_section_id. got %d", *v)
+			}
+			if _, ok := payload["position"]; ok {
+				t.Fatalf("update should not send an unset position")
+			}
+			return []byte(`{}`), nil
+		})
+	m.EXPECT().Get(Any(), Eq("/help_center/sections/1234/translations.json?page=1")).
+		Return([]byte(`{"translations":[{"id":11,"locale":"en-us","title":"Invoices","body":""}],"next_page":null}`), nil).
+		Times(2)
+	m.EXPECT().Get(Any(), Eq("/help_center/sections/1234.json")).
+		Return([]byte(`{"section":{"id":1234,"locale":"en-us","category_id":56,"name":"Invoices"}}`), nil)
+
+	if diags := updateHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterSection returned an error: %v", diags)
+	}
+}
+
+func TestDeleteHelpCenterSectionNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/help_center/sections/1234.json")).Return(newNotFoundError())
+	if diags := deleteHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterSection returned an error: %v", diags)
+	}
+}
+
+func TestHelpCenterPosition(t *testing.T) {
+	r := resourceZendeskHelpCenterSection(&helpCenterBrands{})
+	block := schema.InternalMap(r.Schema).CoreConfigSchema()
+
+	data := func(position cty.Value) *schema.ResourceData {
+		config, err := block.CoerceValue(cty.ObjectVal(map[string]cty.Value{
+			"locale":      cty.StringVal("en-us"),
+			"category_id": cty.NumberIntVal(56),
+			"name":        cty.StringVal("Invoices"),
+			"position":    position,
+		}))
+		if err != nil {
+			t.Fatalf("could not build config: %v", err)
+		}
+		return r.Data(&terraform.InstanceState{ID: "1234", RawConfig: config})
+	}
+
+	if v := helpCenterPosition(data(cty.NullVal(cty.Number))); v != nil {
+		t.Fatalf("position should be left to Zendesk when not configured. got %d", *v)
+	}
+	if v := helpCenterPosition(data(cty.NumberIntVal(0))); v == nil || *v != 0 {
+		t.Fatalf("configured position 0 should be sent. got %v", v)
+	}
+}
+
+func TestUpdateHelpCenterSectionPositionZero(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"locale":      "en-us",
+			"category_id": 56,
+			"name":        "Invoices",
+			"position":    0,
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/help_center/sections/1234.json"), Any()).
+		DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+			payload := data.(map[string]interface{})["section"].(map[string]interface{})
+			if v, ok := payload["position"]; !ok || v != int64(0) {
+				t.Fatalf("update should send position 0. got %v", payload)
+			}
+			return []byte(`{}`), nil
+		})
+	m.EXPECT().Get(Any(), Eq("/help_center/sections/1234/translations.json?page=1")).
+		Return([]byte(`{"translations":[{"id":11,"locale":"en-us","title":"Invoices","body":""}],"next_page":null}`), nil).
+		Times(2)
+	m.EXPECT().Get(Any(), Eq("/help_center/sections/1234.json")).
+		Return([]byte(`{"section":{"id":1234,"locale":"en-us","category_id":56,"name":"Invoices","position":0}}`), nil)
+
+	if diags := updateHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterSection returned an error: %v", diags)
+	}
+}
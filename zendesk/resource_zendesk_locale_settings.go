@@ -0,0 +1,174 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// localeSettingsID is the id of the singleton zendesk_locale_settings resource
+const localeSettingsID = "locale_settings"
+
+type accountLocale struct {
+	ID      int64  `json:"id"`
+	Locale  string `json:"locale"`
+	Name    string `json:"name"`
+	Default bool   `json:"default,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/locales/
+func resourceZendeskLocaleSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the locales enabled on the account. There is a single locale settings resource per account, " +
+			"and destroying it leaves the enabled locales unchanged.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createLocaleSettings(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readLocaleSettings(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateLocaleSettings(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			d.SetId("")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.NewValueKnown("default_locale") || !d.NewValueKnown("locales") {
+				return nil
+			}
+			return validateLocaleSettings(d.Get("default_locale").(string), d.Get("locales").(*schema.Set))
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_locale": {
+				Description: "The code of the default locale of the account, e.g. `en-US`. It must be one of `locales`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"locales": {
+				Description: "The codes of all locales enabled for agents and end users, including the default locale. Codes are case sensitive and must be written as Zendesk returns them, e.g. `ja` or `pt-BR`.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func validateLocaleSettings(defaultLocale string, locales *schema.Set) error {
+	if !locales.Contains(defaultLocale) {
+		return fmt.Errorf("default_locale %s must be one of locales", defaultLocale)
+	}
+	return nil
+}
+
+func createLocaleSettings(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	d.SetId(localeSettingsID)
+	return updateLocaleSettings(ctx, d, zd)
+}
+
+func readLocaleSettings(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only the locales enabled on the account are listed
+	enabled, err := fetchLocales(ctx, zd, "/locales.json")
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	fields := map[string]interface{}{
+		"default_locale": "",
+		"locales":        []string{},
+	}
+
+	locales := make([]string, 0, len(enabled))
+	for _, l := range enabled {
+		locales = append(locales, l.Locale)
+		if l.Default {
+			fields["default_locale"] = l.Locale
+		}
+	}
+	fields["locales"] = locales
+
+	err = setSchemaFields(d, fields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateLocaleSettings(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	// Locales not enabled yet are only listed among the public locales
+	available, err := fetchLocales(ctx, zd, "/locales/public.json")
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ids := make(map[string]int64, len(available))
+	for _, l := range available {
+		ids[l.Locale] = l.ID
+	}
+
+	defaultLocale := d.Get("default_locale").(string)
+	defaultLocaleID, ok := ids[defaultLocale]
+	if !ok {
+		return diag.Errorf("unknown locale %s", defaultLocale)
+	}
+
+	localeIDs := []int64{}
+	if v, ok := d.GetOk("locales"); ok {
+		for _, code := range v.(*schema.Set).List() {
+			id, ok := ids[code.(string)]
+			if !ok {
+				return diag.Errorf("unknown locale %s", code)
+			}
+			localeIDs = append(localeIDs, id)
+		}
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"localization": map[string]interface{}{
+				"default_locale_id": defaultLocaleID,
+				"locale_ids":        localeIDs,
+			},
+		},
+	}
+	_, err = zd.Put(ctx, "/account/settings.json", payload)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readLocaleSettings(ctx, d, zd)
+}
+
+func fetchLocales(ctx context.Context, zd client.BaseAPI, path string) ([]accountLocale, error) {
+	body, err := zd.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Locales []accountLocale `json:"locales"`
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result.Locales, err
+}
@@ -0,0 +1,87 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testPublicLocalesResponse = `{
+  "locales": [
+    {"id": 1, "locale": "en-US", "name": "English"},
+    {"id": 67, "locale": "ja", "name": "Japanese"},
+    {"id": 8, "locale": "de", "name": "German"}
+  ]
+}`
+
+func TestValidateLocaleSettings(t *testing.T) {
+	locales := schema.NewSet(schema.HashString, []interface{}{"en-US", "ja"})
+
+	if err := validateLocaleSettings("ja", locales); err != nil {
+		t.Fatalf("validateLocaleSettings returned an error: %v", err)
+	}
+	if err := validateLocaleSettings("de", locales); err == nil {
+		t.Fatalf("validateLocaleSettings should fail when the default locale is not enabled")
+	}
+}
+
+func TestCreateLocaleSettings(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"default_locale": "en-US",
+			"locales":        schema.NewSet(schema.HashString, []interface{}{"en-US", "ja"}),
+		},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/locales/public.json")).Return([]byte(testPublicLocalesResponse), nil)
+	m.EXPECT().Put(Any(), Eq("/account/settings.json"), Any()).
+		DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+			localization := data.(map[string]interface{})["settings"].(map[string]interface{})["localization"].(map[string]interface{})
+			if v := localization["default_locale_id"]; v != int64(1) {
+				t.Fatalf("update sent default_locale_id %v. should have been 1", v)
+			}
+			if v := localization["locale_ids"].([]int64); len(v) != 2 {
+				t.Fatalf("update sent locale_ids %v. should have had 2 locales", v)
+			}
+			return []byte(`{}`), nil
+		})
+	m.EXPECT().Get(Any(), Eq("/locales.json")).
+		Return([]byte(`{"locales":[{"id":1,"locale":"en-US","name":"English","default":true},{"id":67,"locale":"ja","name":"Japanese","default":false}]}`), nil)
+
+	if diags := createLocaleSettings(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createLocaleSettings returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != localeSettingsID {
+		t.Fatalf("createLocaleSettings set resource id %s. should have been %s", v, localeSettingsID)
+	}
+	if v := i.Get("default_locale"); v != "en-US" {
+		t.Fatalf("createLocaleSettings set default_locale %v. should have been en-US", v)
+	}
+}
+
+func TestUpdateLocaleSettingsUnknownLocale(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: localeSettingsID,
+		mapGetterSetter: mapGetterSetter{
+			"default_locale": "en-US",
+			"locales":        schema.NewSet(schema.HashString, []interface{}{"en-US", "xx"}),
+		},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/locales/public.json")).Return([]byte(testPublicLocalesResponse), nil)
+	if diags := updateLocaleSettings(context.Background(), i, m); !diags.HasError() {
+		t.Fatalf("updateLocaleSettings should fail for an unknown locale")
+	}
+}
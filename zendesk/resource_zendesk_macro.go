@@ -0,0 +1,319 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// macro replaces the actions of client.Macro, whose string values can't
+// hold list values such as ["comment_value_html", "..."]. Since go-zendesk
+// would fail to decode those macros, requests are sent with BaseAPI.
+type macro struct {
+	client.Macro
+	Actions []client.TriggerAction `json:"actions"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/
+func resourceZendeskMacro() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a macro resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createMacro(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readMacro(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateMacro(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteMacro(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "The API url of this macro.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"title": {
+				Description: "The title of the macro.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the macro.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"active": {
+				Description: "Whether the macro is active.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"position": {
+				Description: "The position of the macro.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"restriction": restrictionSchema("Who may access this macro. Available to all agents if not set."),
+			"action": {
+				Description: "What the macro will do. Values which take a list, such as `comment_value_html`, are written as JSON arrays.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalMacro(m macro, d identifiableGetterSetter) error {
+	description, _ := m.Description.(string)
+
+	fields := map[string]interface{}{
+		"url":         m.URL,
+		"title":       m.Title,
+		"description": description,
+		"active":      m.Active,
+		"position":    m.Position,
+	}
+
+	restriction, err := marshalRestriction(m.Restriction)
+	if err != nil {
+		return fmt.Errorf("error decoding macro restriction: %s", err)
+	}
+	fields["restriction"] = restriction
+
+	var actions []map[string]interface{}
+	for _, action := range m.Actions {
+
+		// If the macro value is a string, leave it be
+		// If it's a list, marshal it to a string
+		var stringVal string
+		switch v := action.Value.(type) {
+		case []interface{}:
+			tmp, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("error decoding macro action value: %s", err)
+			}
+			stringVal = string(tmp)
+		case string:
+			stringVal = v
+		case float64:
+			stringVal = strconv.FormatFloat(v, 'f', -1, 64)
+		case nil:
+		default:
+			stringVal = fmt.Sprintf("%v", v)
+		}
+
+		actions = append(actions, map[string]interface{}{
+			"field": action.Field,
+			"value": stringVal,
+		})
+	}
+	fields["action"] = actions
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalMacro(d identifiableGetterSetter) (macro, error) {
+	m := macro{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return m, fmt.Errorf("could not parse macro id %s: %v", v, err)
+		}
+		m.ID = id
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		m.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		m.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("active"); ok {
+		m.Active = v.(bool)
+	}
+
+	if v, ok := d.GetOk("restriction"); ok {
+		r, err := unmarshalRestriction(v.([]interface{}))
+		if err != nil {
+			return m, fmt.Errorf("could not parse restriction for macro %v: %v", m.Title, err)
+		}
+		m.Restriction = r
+	}
+
+	if v, ok := d.GetOk("action"); ok {
+		macroActions := v.(*schema.Set).List()
+		actions := []client.TriggerAction{}
+		for _, a := range macroActions {
+			action, ok := a.(map[string]interface{})
+			if !ok {
+				return m, fmt.Errorf("could not parse actions for macro %v", m.Title)
+			}
+
+			// If the action value is a list, unmarshal it
+			var actionValue interface{}
+			if strings.HasPrefix(action["value"].(string), "[") {
+				err := json.Unmarshal([]byte(action["value"].(string)), &actionValue)
+				if err != nil {
+					return m, fmt.Errorf("error unmarshalling macro action value: %s", err)
+				}
+			} else {
+				actionValue = action["value"]
+			}
+
+			actions = append(actions, client.TriggerAction{
+				Field: action["field"].(string),
+				Value: actionValue,
+			})
+		}
+		m.Actions = actions
+	}
+
+	return m, nil
+}
+
+func createMacro(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m, err := unmarshalMacro(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/macros.json", map[string]interface{}{"macro": m})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	m, err = decodeMacro(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", m.ID))
+
+	err = marshalMacro(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readMacro(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/macros/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Macro")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	m, err := decodeMacro(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalMacro(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateMacro(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m, err := unmarshalMacro(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/macros/%d.json", m.ID), map[string]interface{}{"macro": m})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	m, err = decodeMacro(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalMacro(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteMacro(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/macros/%d.json", id))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeMacro(body []byte) (macro, error) {
+	var result struct {
+		Macro macro `json:"macro"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Macro, err
+}
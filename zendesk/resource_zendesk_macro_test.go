@@ -0,0 +1,256 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalMacro(t *testing.T) {
+	expected := macro{
+		Macro: zendesk.Macro{
+			Title:       "title",
+			Description: "blabla",
+			Active:      true,
+			Restriction: map[string]interface{}{
+				"type": "Group",
+				"id":   float64(10),
+				"ids":  []interface{}{float64(10), float64(20)},
+			},
+		},
+		Actions: []zendesk.TriggerAction{
+			{Field: "status", Value: "solved"},
+			{Field: "comment_value_html", Value: []interface{}{"channel:all", "Thanks"}},
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err := marshalMacro(expected, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	v, ok := m.GetOk("title")
+	if !ok {
+		t.Fatal("Failed to get title value")
+	}
+	if v != expected.Title {
+		t.Fatalf("macro had incorrect title value %v. should have been %v", v, expected.Title)
+	}
+
+	v, ok = m.GetOk("description")
+	if !ok {
+		t.Fatal("Failed to get description value")
+	}
+	if v != expected.Description {
+		t.Fatalf("macro had incorrect description value %v. should have been %v", v, expected.Description)
+	}
+
+	restriction := m.Get("restriction").([]map[string]interface{})
+	if len(restriction) != 1 || restriction[0]["type"] != "Group" {
+		t.Fatalf("macro had incorrect restriction value %v", restriction)
+	}
+	if ids := restriction[0]["ids"].([]int64); len(ids) != 2 || ids[0] != 10 || ids[1] != 20 {
+		t.Fatalf("macro had incorrect restriction ids %v. should have been [10 20]", ids)
+	}
+
+	actions := m.Get("action").([]map[string]interface{})
+	if len(actions) != 2 {
+		t.Fatalf("macro had %d actions. should have been 2", len(actions))
+	}
+	if v := actions[1]["value"]; v != `["channel:all","Thanks"]` {
+		t.Fatalf("macro had incorrect list action value %v", v)
+	}
+}
+
+func TestMarshalMacroUserRestriction(t *testing.T) {
+	expected := macro{
+		Macro: zendesk.Macro{
+			Title:       "title",
+			Restriction: map[string]interface{}{"type": "User", "id": float64(30)},
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err := marshalMacro(expected, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	restriction := m.Get("restriction").([]map[string]interface{})
+	if ids := restriction[0]["ids"].([]int64); len(ids) != 1 || ids[0] != 30 {
+		t.Fatalf("macro had incorrect restriction ids %v. should have been [30]", ids)
+	}
+}
+
+func TestUnmarshalMacro(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "100",
+		mapGetterSetter: mapGetterSetter{
+			"title":       "Close ticket",
+			"description": "close and thank the requester",
+			"active":      true,
+			"restriction": []interface{}{
+				map[string]interface{}{
+					"type": "User",
+					"ids":  schema.NewSet(schema.HashInt, []interface{}{30}),
+				},
+			},
+			"action": schema.NewSet(schema.HashResource(resourceZendeskMacro().Schema["action"].Elem.(*schema.Resource)), []interface{}{
+				map[string]interface{}{
+					"field": "comment_value_html",
+					"value": `["channel:all","Thanks"]`,
+				},
+			}),
+		},
+	}
+
+	mac, err := unmarshalMacro(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if mac.ID != 100 {
+		t.Fatalf("macro had id value %v. should have been 100", mac.ID)
+	}
+	if v := m.Get("title"); mac.Title != v {
+		t.Fatalf("macro had title value %v. should have been %v", mac.Title, v)
+	}
+
+	r := mac.Restriction.(accessRestriction)
+	if r.Type != "User" || r.ID != 30 || len(r.IDs) != 0 {
+		t.Fatalf("macro had incorrect restriction value %v", r)
+	}
+
+	if len(mac.Actions) != 1 {
+		t.Fatalf("macro had %d actions. should have been 1", len(mac.Actions))
+	}
+	values, ok := mac.Actions[0].Value.([]interface{})
+	if !ok || len(values) != 2 || values[1] != "Thanks" {
+		t.Fatalf("macro had incorrect list action value %v", mac.Actions[0].Value)
+	}
+}
+
+func TestCreateMacro(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/macros.json"), gomock.Any()).
+		Return([]byte(`{"macro":{"id":12345,"title":"macro","actions":[{"field":"status","value":"solved"}]}}`), nil)
+	if diags := createMacro(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createMacro returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("createMacro did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("title"); v != "macro" {
+		t.Fatalf("createMacro did not set resource title. title was %s", v)
+	}
+}
+
+func TestReadMacro(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/macros/12345.json")).
+		Return([]byte(`{"macro":{"id":12345,"title":"macro","active":true,"actions":[{"field":"comment_value_html","value":["channel:all","Thanks"]}]}}`), nil)
+	if diags := readMacro(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readMacro returned an error: %v", diags)
+	}
+
+	actions := i.Get("action").([]map[string]interface{})
+	if len(actions) != 1 || actions[0]["value"] != `["channel:all","Thanks"]` {
+		t.Fatalf("readMacro did not set list action value. actions were %v", actions)
+	}
+}
+
+func TestReadMacroNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/macros/12345.json")).Return(nil, newNotFoundError())
+	diags := readMacro(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readMacro returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readMacro should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readMacro did not remove resource from state. Id was %s", v)
+	}
+}
+
+func TestUpdateMacro(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "12345",
+		mapGetterSetter: mapGetterSetter{
+			"title": "macro",
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/macros/12345.json"), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			Macro map[string]interface{} `json:"macro"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if v, ok := req.Macro["restriction"]; !ok || v != nil {
+			t.Fatalf("update request did not send restriction=null: %s", b)
+		}
+
+		return []byte(`{"macro":{"id":12345,"title":"macro"}}`), nil
+	})
+	if diags := updateMacro(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateMacro returned an error %v", diags)
+	}
+}
+
+func TestDeleteMacro(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("1234")
+
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/macros/1234.json")).Return(nil)
+	diags := deleteMacro(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from resource delete: %v", diags)
+	}
+}
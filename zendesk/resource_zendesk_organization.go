@@ -76,19 +76,51 @@ func resourceZendeskOrganization() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"external_id": {
+				Description: "A unique external id to associate organizations to an external record. Removing it from the configuration keeps the current value.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"details": {
+				Description: "Any details about the organization, such as the address. Removing it from the configuration keeps the current value.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"notes": {
+				Description: "Any notes you have about the organization. Removing it from the configuration keeps the current value.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_fields": {
+				Description: "Values of custom organization fields keyed by field key. Only the configured fields are managed, so other fields and fields removed from the map keep their values.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
 		},
 	}
 }
 
 func marshalOrganization(org client.Organization, d identifiableGetterSetter) error {
+	organizationFields := marshalCustomFieldValues(org.OrganizationFields, d.Get("organization_fields"))
+
 	fields := map[string]interface{}{
-		"url":             org.URL,
-		"name":            org.Name,
-		"domain_names":    org.DomainNames,
-		"group_id":        org.GroupID,
-		"shared_tickets":  org.SharedTickets,
-		"shared_comments": org.SharedComments,
-		"tags":            org.Tags,
+		"url":                 org.URL,
+		"name":                org.Name,
+		"domain_names":        org.DomainNames,
+		"group_id":            org.GroupID,
+		"shared_tickets":      org.SharedTickets,
+		"shared_comments":     org.SharedComments,
+		"tags":                org.Tags,
+		"external_id":         org.ExternalID,
+		"details":             org.Details,
+		"notes":               org.Notes,
+		"organization_fields": organizationFields,
 	}
 
 	return setSchemaFields(d, fields)
@@ -139,6 +171,25 @@ func unmarshalOrganization(d identifiableGetterSetter) (client.Organization, err
 		}
 	}
 
+	if v, ok := d.GetOk("external_id"); ok {
+		org.ExternalID = v.(string)
+	}
+
+	if v, ok := d.GetOk("details"); ok {
+		org.Details = v.(string)
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		org.Notes = v.(string)
+	}
+
+	if v, ok := d.GetOk("organization_fields"); ok {
+		org.OrganizationFields = make(map[string]interface{})
+		for key, value := range v.(map[string]interface{}) {
+			org.OrganizationFields[key] = value
+		}
+	}
+
 	return org, nil
 }
 
@@ -152,7 +203,7 @@ func createOrganization(ctx context.Context, d identifiableGetterSetter, zd clie
 
 	org, err = zd.CreateOrganization(ctx, org)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", org.ID))
@@ -174,8 +225,11 @@ func readOrganization(ctx context.Context, d identifiableGetterSetter, zd client
 	}
 
 	org, err := zd.GetOrganization(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Organization")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalOrganization(org, d)
@@ -201,7 +255,7 @@ func updateOrganization(ctx context.Context, d identifiableGetterSetter, zd clie
 
 	org, err = zd.UpdateOrganization(ctx, id, org)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalOrganization(org, d)
@@ -222,7 +276,7 @@ func deleteOrganization(ctx context.Context, d identifiableGetterSetter, zd clie
 
 	err = zd.DeleteOrganization(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
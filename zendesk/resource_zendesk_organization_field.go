@@ -0,0 +1,65 @@
+package zendesk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+var organizationFieldKind = customFieldKind{
+	name: "Organization field",
+	key:  "organization_field",
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/
+func resourceZendeskOrganizationField() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a custom organization field resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createOrganizationField(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readOrganizationField(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateOrganizationField(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteOrganizationField(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				zd := meta.(*client.Client)
+				if err := importCustomField(ctx, d, zd, organizationFieldKind); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: validateCustomFieldDiff,
+
+		Schema: customFieldSchema("organization"),
+	}
+}
+
+func createOrganizationField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	return createCustomField(ctx, d, zd, organizationFieldKind)
+}
+
+func readOrganizationField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	return readCustomField(ctx, d, zd, organizationFieldKind)
+}
+
+func updateOrganizationField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	return updateCustomField(ctx, d, zd, organizationFieldKind)
+}
+
+func deleteOrganizationField(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	return deleteCustomField(ctx, d, zd, organizationFieldKind)
+}
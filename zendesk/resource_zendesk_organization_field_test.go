@@ -0,0 +1,80 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testOrganizationFieldResponse = `{
+  "organization_field": {
+    "id": 5678,
+    "key": "account_manager",
+    "type": "lookup",
+    "title": "Account manager",
+    "active": true,
+    "relationship_target_type": "zen:user"
+  }
+}`
+
+func TestCreateOrganizationField(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"key":                      "account_manager",
+			"type":                     "lookup",
+			"title":                    "Account manager",
+			"relationship_target_type": "zen:user",
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/organization_fields.json"), Any()).Return([]byte(testOrganizationFieldResponse), nil)
+	if diags := createOrganizationField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createOrganizationField returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "5678" {
+		t.Fatalf("createOrganizationField did not set resource id. Id was %s", v)
+	}
+	if v := i.Get("relationship_target_type"); v != "zen:user" {
+		t.Fatalf("organization field had relationship_target_type %v. should have been zen:user", v)
+	}
+}
+
+func TestReadOrganizationFieldNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("5678")
+
+	m.EXPECT().Get(Any(), Eq("/organization_fields/5678.json")).Return(nil, newNotFoundError())
+	if diags := readOrganizationField(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readOrganizationField returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readOrganizationField did not remove organization field from state. Id was %s", v)
+	}
+}
+
+func TestDeleteOrganizationField(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "5678",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/organization_fields/5678.json")).Return(nil)
+	if diags := deleteOrganizationField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteOrganizationField returned an error: %v", diags)
+	}
+}
@@ -0,0 +1,246 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// organizationMembershipAPI adds the raw requests needed for read and delete,
+// which go-zendesk OrganizationMembershipAPI does not cover
+type organizationMembershipAPI interface {
+	client.OrganizationMembershipAPI
+	client.BaseAPI
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/
+func resourceZendeskOrganizationMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides an organization membership resource, which adds a user to an organization.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createOrganizationMembership(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readOrganizationMembership(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateOrganizationMembership(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteOrganizationMembership(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				zd := meta.(*client.Client)
+				if err := importOrganizationMembership(ctx, d, zd); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "The API url of this organization membership.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "The id of a user.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"organization_id": {
+				Description: "The id of an organization.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default": {
+				Description:      "If true, this is the default organization of the user. Zendesk unsets it when another membership of the user becomes the default, so false is ignored.",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressUnsetDefault,
+			},
+		},
+	}
+}
+
+func marshalOrganizationMembership(m client.OrganizationMembership, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":             m.URL,
+		"user_id":         m.UserID,
+		"organization_id": m.OrganizationID,
+		"default":         m.Default,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalOrganizationMembership(d identifiableGetterSetter) (client.OrganizationMembership, error) {
+	m := client.OrganizationMembership{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return m, fmt.Errorf("could not parse organization membership id %s: %v", v, err)
+		}
+		m.ID = id
+	}
+
+	if v, ok := d.GetOk("url"); ok {
+		m.URL = v.(string)
+	}
+
+	if v, ok := d.GetOk("user_id"); ok {
+		m.UserID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("organization_id"); ok {
+		m.OrganizationID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("default"); ok {
+		m.Default = v.(bool)
+	}
+
+	return m, nil
+}
+
+func createOrganizationMembership(ctx context.Context, d identifiableGetterSetter, zd organizationMembershipAPI) diag.Diagnostics {
+	m, err := unmarshalOrganizationMembership(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := client.OrganizationMembershipOptions{
+		UserID:         m.UserID,
+		OrganizationID: m.OrganizationID,
+	}
+
+	// Actual API request
+	created, err := zd.CreateOrganizationMembership(ctx, opts)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", created.ID))
+
+	// The first membership of a user becomes the default regardless of the payload
+	if m.Default && !created.Default {
+		if _, err := zd.SetDefaultOrganization(ctx, opts); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readOrganizationMembership(ctx, d, zd)
+}
+
+func readOrganizationMembership(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// go-zendesk can only list memberships
+	body, err := zd.Get(ctx, fmt.Sprintf("/organization_memberships/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Organization membership")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var result struct {
+		OrganizationMembership client.OrganizationMembership `json:"organization_membership"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalOrganizationMembership(result.OrganizationMembership, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// updateOrganizationMembership only handles default since the other attributes force a new membership
+func updateOrganizationMembership(ctx context.Context, d identifiableGetterSetter, zd organizationMembershipAPI) diag.Diagnostics {
+	m, err := unmarshalOrganizationMembership(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if m.Default {
+		opts := client.OrganizationMembershipOptions{
+			UserID:         m.UserID,
+			OrganizationID: m.OrganizationID,
+		}
+		if _, err := zd.SetDefaultOrganization(ctx, opts); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readOrganizationMembership(ctx, d, zd)
+}
+
+func deleteOrganizationMembership(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// go-zendesk does not delete memberships
+	err = zd.Delete(ctx, fmt.Sprintf("/organization_memberships/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// importOrganizationMembership accepts either the membership id or organization_id:user_id
+func importOrganizationMembership(ctx context.Context, d identifiable, zd organizationMembershipAPI) error {
+	if _, err := atoi64(d.Id()); err == nil {
+		return nil
+	}
+
+	ids, err := parseCompositeID(d.Id(), "organization_id", "user_id")
+	if err != nil {
+		return err
+	}
+	organizationID, userID := ids[0], ids[1]
+
+	memberships, _, err := zd.GetOrganizationMemberships(ctx, &client.OrganizationMembershipListOptions{
+		PageOptions: client.PageOptions{PerPage: 100},
+		UserID:      userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range memberships {
+		if m.OrganizationID == organizationID {
+			d.SetId(fmt.Sprintf("%d", m.ID))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("user %d is not a member of organization %d", userID, organizationID)
+}
@@ -0,0 +1,192 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalOrganizationMembership(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	om := zendesk.OrganizationMembership{
+		URL:            "https://example.zendesk.com/api/v2/organization_memberships/4.json",
+		UserID:         1,
+		OrganizationID: 2,
+		Default:        true,
+	}
+
+	err := marshalOrganizationMembership(om, m)
+	if err != nil {
+		t.Fatalf("Could not marshal map %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"url":             om.URL,
+		"user_id":         om.UserID,
+		"organization_id": om.OrganizationID,
+		"default":         om.Default,
+	}
+	for k, v := range expected {
+		if m.Get(k) != v {
+			t.Fatalf("organization membership had incorrect %s value %v. should have been %v", k, m.Get(k), v)
+		}
+	}
+}
+
+func TestDefaultOrganizationMembershipDiffSuppressed(t *testing.T) {
+	s := resourceZendeskOrganizationMembership().Schema["default"]
+
+	if !s.DiffSuppressFunc("default", "true", "false", nil) {
+		t.Fatalf("default=false should not produce a diff when another membership flipped it")
+	}
+	if s.DiffSuppressFunc("default", "false", "true", nil) {
+		t.Fatalf("default=true should produce a diff when the membership is not the default")
+	}
+}
+
+func TestCreateOrganizationMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"user_id":         1,
+			"organization_id": 2,
+		},
+	}
+
+	m.EXPECT().CreateOrganizationMembership(Any(), Eq(zendesk.OrganizationMembershipOptions{UserID: 1, OrganizationID: 2})).
+		Return(zendesk.OrganizationMembership{ID: 4, UserID: 1, OrganizationID: 2}, nil)
+	m.EXPECT().Get(Any(), Eq("/organization_memberships/4.json")).
+		Return([]byte(`{"organization_membership":{"id":4,"user_id":1,"organization_id":2,"default":false}}`), nil)
+	if diags := createOrganizationMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("create organization membership returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "4" {
+		t.Fatalf("Create did not set resource id. Id was %s", v)
+	}
+}
+
+func TestCreateOrganizationMembershipMakesDefault(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"user_id":         1,
+			"organization_id": 2,
+			"default":         true,
+		},
+	}
+
+	m.EXPECT().CreateOrganizationMembership(Any(), Eq(zendesk.OrganizationMembershipOptions{UserID: 1, OrganizationID: 2})).
+		Return(zendesk.OrganizationMembership{ID: 4, UserID: 1, OrganizationID: 2}, nil)
+	m.EXPECT().SetDefaultOrganization(Any(), Eq(zendesk.OrganizationMembershipOptions{UserID: 1, OrganizationID: 2})).
+		Return(zendesk.OrganizationMembership{ID: 4, UserID: 1, OrganizationID: 2, Default: true}, nil)
+	m.EXPECT().Get(Any(), Eq("/organization_memberships/4.json")).
+		Return([]byte(`{"organization_membership":{"id":4,"user_id":1,"organization_id":2,"default":true}}`), nil)
+	if diags := createOrganizationMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("create organization membership returned an error: %v", diags)
+	}
+
+	if v := i.Get("default"); v != true {
+		t.Fatalf("Create did not set default from response. default was %v", v)
+	}
+}
+
+func TestReadOrganizationMembershipNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("4")
+
+	m.EXPECT().Get(Any(), Eq("/organization_memberships/4.json")).Return(nil, newNotFoundError())
+	if diags := readOrganizationMembership(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readOrganizationMembership returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readOrganizationMembership did not remove resource from state. Id was %s", v)
+	}
+}
+
+func TestUpdateOrganizationMembershipMakesDefault(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4",
+		mapGetterSetter: mapGetterSetter{
+			"user_id":         1,
+			"organization_id": 2,
+			"default":         true,
+		},
+	}
+
+	m.EXPECT().SetDefaultOrganization(Any(), Eq(zendesk.OrganizationMembershipOptions{UserID: 1, OrganizationID: 2})).
+		Return(zendesk.OrganizationMembership{ID: 4, UserID: 1, OrganizationID: 2, Default: true}, nil)
+	m.EXPECT().Get(Any(), Eq("/organization_memberships/4.json")).
+		Return([]byte(`{"organization_membership":{"id":4,"user_id":1,"organization_id":2,"default":true}}`), nil)
+	if diags := updateOrganizationMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateOrganizationMembership returned an error: %v", diags)
+	}
+}
+
+func TestDeleteOrganizationMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/organization_memberships/4.json")).Return(nil)
+	if diags := deleteOrganizationMembership(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteOrganizationMembership returned an error: %v", diags)
+	}
+}
+
+func TestImportOrganizationMembership(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+
+	m.EXPECT().GetOrganizationMemberships(Any(), Any()).
+		DoAndReturn(func(_ context.Context, opts *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+			if opts.UserID != 1 {
+				t.Fatalf("memberships were listed for user %d. should have been 1", opts.UserID)
+			}
+			return []zendesk.OrganizationMembership{
+				{ID: 3, UserID: 1, OrganizationID: 5},
+				{ID: 4, UserID: 1, OrganizationID: 2},
+			}, zendesk.Page{}, nil
+		})
+
+	i.SetId("2:1")
+	if err := importOrganizationMembership(context.Background(), i, m); err != nil {
+		t.Fatalf("importOrganizationMembership returned an error: %v", err)
+	}
+	if v := i.Id(); v != "4" {
+		t.Fatalf("importOrganizationMembership resolved id %s. should have been 4", v)
+	}
+
+	i.SetId("2-1")
+	if err := importOrganizationMembership(context.Background(), i, m); err == nil {
+		t.Fatalf("importOrganizationMembership should return an error for malformed id")
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	//"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	//"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -69,6 +72,116 @@ func TestUnmarshalOrganization(t *testing.T) {
 	}
 }
 
+func TestMarshalOrganizationFields(t *testing.T) {
+	org := zendesk.Organization{
+		Name:       "Rebel Alliance",
+		ExternalID: "crm-1234",
+		Notes:      "Key account",
+		OrganizationFields: map[string]interface{}{
+			"account_tier": "gold",
+			"seats":        float64(25),
+			"vip":          true,
+			"renewal":      nil,
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"organization_fields": map[string]interface{}{"account_tier": "silver", "seats": "10", "renewal": "2024-01-01"},
+		},
+	}
+
+	err := marshalOrganization(org, m)
+	if err != nil {
+		t.Fatalf("Could marshal map %v", err)
+	}
+
+	if v := m.Get("external_id"); v != org.ExternalID {
+		t.Fatalf("organization had external_id %v. should have been %v", v, org.ExternalID)
+	}
+	if v := m.Get("notes"); v != org.Notes {
+		t.Fatalf("organization had notes %v. should have been %v", v, org.Notes)
+	}
+
+	// Only configured fields are kept
+	organizationFields := m.Get("organization_fields").(map[string]interface{})
+	expected := map[string]interface{}{
+		"account_tier": "gold",
+		"seats":        "25",
+	}
+	if len(organizationFields) != len(expected) {
+		t.Fatalf("organization had organization_fields %v. should have been %v", organizationFields, expected)
+	}
+	for k, v := range expected {
+		if organizationFields[k] != v {
+			t.Fatalf("organization had organization_fields.%s %v. should have been %v", k, organizationFields[k], v)
+		}
+	}
+}
+
+func TestUnmarshalOrganizationFields(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":        "Rebel Alliance",
+			"external_id": "crm-1234",
+			"details":     "Yavin 4",
+			"organization_fields": map[string]interface{}{
+				"account_tier": "gold",
+			},
+		},
+	}
+
+	org, err := unmarshalOrganization(m)
+	if err != nil {
+		t.Fatalf("Could not unmarshal map %v", err)
+	}
+
+	if org.ExternalID != "crm-1234" || org.Details != "Yavin 4" {
+		t.Fatalf("organization had incorrect values %v", org)
+	}
+	if v := org.OrganizationFields["account_tier"]; v != "gold" {
+		t.Fatalf("organization had organization_fields.account_tier %v. should have been gold", v)
+	}
+}
+
+func TestReadOrganizationSubsetOfOrganizationFieldsHasNoDiff(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceZendeskOrganization()
+	raw := map[string]interface{}{
+		"name": "Rebel Alliance",
+		"organization_fields": map[string]interface{}{
+			"account_tier": "gold",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("1234")
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetOrganization(Any(), Eq(int64(1234))).Return(zendesk.Organization{
+		ID:   1234,
+		Name: "Rebel Alliance",
+		OrganizationFields: map[string]interface{}{
+			"account_tier": "gold",
+			"vip":          false,
+			"seats":        float64(25),
+		},
+	}, nil)
+
+	if diags := readOrganization(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("readOrganization returned an error: %v", diags)
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("Diff returned an error: %v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("plan after read should be empty. got %v", diff.Attributes)
+	}
+}
+
 func TestReadOrganization(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -100,6 +213,27 @@ func TestReadOrganization(t *testing.T) {
 	}
 }
 
+func TestReadOrganizationNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetOrganization(Any(), Eq(int64(12345))).Return(zendesk.Organization{}, newNotFoundError())
+	diags := readOrganization(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readOrganization returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readOrganization should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readOrganization did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestCreateOrganization(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
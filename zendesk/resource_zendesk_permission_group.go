@@ -0,0 +1,231 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type permissionGroup struct {
+	ID      int64   `json:"id,omitempty"`
+	Name    string  `json:"name"`
+	Edit    []int64 `json:"edit"`
+	Publish []int64 `json:"publish"`
+	BuiltIn bool    `json:"built_in,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/
+func resourceZendeskPermissionGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center permission group resource. Permission groups define which agents can edit and publish articles.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createPermissionGroup(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readPermissionGroup(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updatePermissionGroup(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deletePermissionGroup(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the permission group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"edit_group_ids": {
+				Description: "The ids of the groups whose agents can create and edit articles.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"publish_group_ids": {
+				Description: "The ids of the groups whose agents can publish articles.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"built_in": {
+				Description: "Whether the permission group is built-in. Built-in permission groups cannot be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalPermissionGroup(group permissionGroup, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":              group.Name,
+		"edit_group_ids":    group.Edit,
+		"publish_group_ids": group.Publish,
+		"built_in":          group.BuiltIn,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalPermissionGroup(d identifiableGetterSetter) (permissionGroup, error) {
+	group := permissionGroup{
+		Edit:    []int64{},
+		Publish: []int64{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return group, fmt.Errorf("could not parse permission group id %s: %v", v, err)
+		}
+		group.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		group.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("edit_group_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			group.Edit = append(group.Edit, int64(id.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("publish_group_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			group.Publish = append(group.Publish, int64(id.(int)))
+		}
+	}
+
+	return group, nil
+}
+
+func createPermissionGroup(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, err := unmarshalPermissionGroup(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/guide/permission_groups.json", map[string]interface{}{"permission_group": group})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	group, err = decodePermissionGroup(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", group.ID))
+
+	err = marshalPermissionGroup(group, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readPermissionGroup(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Permission group")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	group, err := decodePermissionGroup(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalPermissionGroup(group, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updatePermissionGroup(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, err := unmarshalPermissionGroup(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", group.ID), map[string]interface{}{"permission_group": group})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	group, err = decodePermissionGroup(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalPermissionGroup(group, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deletePermissionGroup(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodePermissionGroup(body []byte) (permissionGroup, error) {
+	var result struct {
+		PermissionGroup permissionGroup `json:"permission_group"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.PermissionGroup, err
+}
@@ -0,0 +1,85 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalPermissionGroup(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":              "Documentation team",
+			"edit_group_ids":    schema.NewSet(schema.HashInt, []interface{}{56, 78}),
+			"publish_group_ids": schema.NewSet(schema.HashInt, []interface{}{78}),
+		},
+	}
+
+	group, err := unmarshalPermissionGroup(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if group.ID != 1234 || group.Name != "Documentation team" {
+		t.Fatalf("permission group had incorrect values %v", group)
+	}
+	if len(group.Edit) != 2 || len(group.Publish) != 1 || group.Publish[0] != 78 {
+		t.Fatalf("permission group had incorrect groups. edit %v, publish %v", group.Edit, group.Publish)
+	}
+}
+
+func TestReadPermissionGroup(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/guide/permission_groups/1234.json")).
+		Return([]byte(`{"permission_group":{"id":1234,"name":"Documentation team","edit":[56,78],"publish":[78],"built_in":false}}`), nil)
+	if diags := readPermissionGroup(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readPermissionGroup returned an error: %v", diags)
+	}
+
+	if v := i.Get("edit_group_ids").([]int64); len(v) != 2 {
+		t.Fatalf("readPermissionGroup set edit_group_ids %v. should have had 2 groups", v)
+	}
+}
+
+func TestReadPermissionGroupNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/guide/permission_groups/1234.json")).Return(nil, newNotFoundError())
+	if diags := readPermissionGroup(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readPermissionGroup returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readPermissionGroup did not remove permission group from state. Id was %s", v)
+	}
+}
+
+func TestDeletePermissionGroup(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/guide/permission_groups/1234.json")).Return(nil)
+	if diags := deletePermissionGroup(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deletePermissionGroup returned an error: %v", diags)
+	}
+}
@@ -0,0 +1,384 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type schedule struct {
+	ID        int64              `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []scheduleInterval `json:"intervals,omitempty"`
+}
+
+// scheduleInterval is a period of business hours in minutes from Sunday 00:00
+type scheduleInterval struct {
+	StartTime int `json:"start_time"`
+	EndTime   int `json:"end_time"`
+}
+
+const minutesPerDay = 24 * 60
+
+var scheduleDays = []string{
+	"sunday",
+	"monday",
+	"tuesday",
+	"wednesday",
+	"thursday",
+	"friday",
+	"saturday",
+}
+
+var scheduleTimeRegexp = regexp.MustCompile(`^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$`)
+
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/
+func resourceZendeskSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a business hours schedule resource, which is used by SLA policies with business hours.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createSchedule(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readSchedule(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateSchedule(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteSchedule(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.NewValueKnown("interval") {
+				return nil
+			}
+			_, err := unmarshalScheduleIntervals(d.Get("interval").(*schema.Set).List())
+			return err
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the schedule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"time_zone": {
+				Description: "The time zone of the schedule, e.g. `Pacific Time (US & Canada)`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"interval": {
+				Description: "A weekly period of business hours. Intervals must not overlap or be adjacent.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Description:  "The day of the week, e.g. `monday`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(scheduleDays, false),
+						},
+						"start_time": {
+							Description:  "The start of business hours in `HH:MM`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(scheduleTimeRegexp, "must be HH:MM"),
+						},
+						"end_time": {
+							Description:  "The end of business hours in `HH:MM`. Use `24:00` for the end of the day.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(scheduleTimeRegexp, "must be HH:MM"),
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalSchedule(s schedule, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":      s.Name,
+		"time_zone": s.TimeZone,
+	}
+
+	var intervals []map[string]interface{}
+	for _, i := range s.Intervals {
+		day := i.StartTime / minutesPerDay
+		if day < 0 || day >= len(scheduleDays) {
+			return fmt.Errorf("unexpected schedule interval %v", i)
+		}
+
+		offset := day * minutesPerDay
+		intervals = append(intervals, map[string]interface{}{
+			"day":        scheduleDays[day],
+			"start_time": formatScheduleTime(i.StartTime - offset),
+			"end_time":   formatScheduleTime(i.EndTime - offset),
+		})
+	}
+	fields["interval"] = intervals
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalSchedule(d identifiableGetterSetter) (schedule, error) {
+	s := schedule{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return s, fmt.Errorf("could not parse schedule id %s: %v", v, err)
+		}
+		s.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		s.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("time_zone"); ok {
+		s.TimeZone = v.(string)
+	}
+
+	if v, ok := d.GetOk("interval"); ok {
+		intervals, err := unmarshalScheduleIntervals(v.(*schema.Set).List())
+		if err != nil {
+			return s, err
+		}
+		s.Intervals = intervals
+	}
+
+	return s, nil
+}
+
+// unmarshalScheduleIntervals converts interval blocks to minute offsets
+// and checks that they don't overlap or touch
+func unmarshalScheduleIntervals(list []interface{}) ([]scheduleInterval, error) {
+	intervals := make([]scheduleInterval, 0, len(list))
+	for _, e := range list {
+		interval, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("could not parse schedule interval %v", e)
+		}
+
+		day := -1
+		for i, name := range scheduleDays {
+			if interval["day"] == name {
+				day = i
+			}
+		}
+		if day < 0 {
+			return nil, fmt.Errorf("invalid day %v in schedule interval", interval["day"])
+		}
+
+		start, err := parseScheduleTime(interval["start_time"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := parseScheduleTime(interval["end_time"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		if start >= end {
+			return nil, fmt.Errorf("schedule interval on %s must start before it ends: %s-%s", interval["day"], interval["start_time"], interval["end_time"])
+		}
+
+		offset := day * minutesPerDay
+		intervals = append(intervals, scheduleInterval{
+			StartTime: offset + start,
+			EndTime:   offset + end,
+		})
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].StartTime < intervals[j].StartTime
+	})
+
+	for i := 1; i < len(intervals); i++ {
+		prev, cur := intervals[i-1], intervals[i]
+		day := scheduleDays[cur.StartTime/minutesPerDay]
+		if cur.StartTime < prev.EndTime {
+			return nil, fmt.Errorf("schedule intervals on %s starting at %s and %s overlap", day,
+				formatScheduleTime(prev.StartTime%minutesPerDay), formatScheduleTime(cur.StartTime%minutesPerDay))
+		}
+		// Zendesk rejects intervals that touch, they have to be a single interval
+		if cur.StartTime == prev.EndTime {
+			return nil, fmt.Errorf("schedule intervals on %s starting at %s and %s are adjacent. merge them into one interval", day,
+				formatScheduleTime(prev.StartTime%minutesPerDay), formatScheduleTime(cur.StartTime%minutesPerDay))
+		}
+	}
+
+	return intervals, nil
+}
+
+func parseScheduleTime(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid time %s. must be HH:MM", s)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s. must be HH:MM", s)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s. must be HH:MM", s)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+func formatScheduleTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func createSchedule(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	s, err := unmarshalSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"schedule": map[string]interface{}{
+			"name":      s.Name,
+			"time_zone": s.TimeZone,
+		},
+	}
+	body, err := zd.Post(ctx, "/business_hours/schedules.json", payload)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	created, err := decodeSchedule(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", created.ID))
+
+	// Zendesk creates schedules with default business hours
+	err = putScheduleWorkweek(ctx, zd, created.ID, s.Intervals)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readSchedule(ctx, d, zd)
+}
+
+func readSchedule(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Schedule")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	s, err := decodeSchedule(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalSchedule(s, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateSchedule(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	s, err := unmarshalSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"schedule": map[string]interface{}{
+			"name":      s.Name,
+			"time_zone": s.TimeZone,
+		},
+	}
+	_, err = zd.Put(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", s.ID), payload)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = putScheduleWorkweek(ctx, zd, s.ID, s.Intervals)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return readSchedule(ctx, d, zd)
+}
+
+func deleteSchedule(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func putScheduleWorkweek(ctx context.Context, zd client.BaseAPI, id int64, intervals []scheduleInterval) error {
+	payload := map[string]interface{}{
+		"workweek": map[string]interface{}{
+			"intervals": intervals,
+		},
+	}
+
+	_, err := zd.Put(ctx, fmt.Sprintf("/business_hours/schedules/%d/workweek.json", id), payload)
+	return err
+}
+
+func decodeSchedule(body []byte) (schedule, error) {
+	var result struct {
+		Schedule schedule `json:"schedule"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Schedule, err
+}
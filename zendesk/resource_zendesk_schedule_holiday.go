@@ -0,0 +1,252 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type scheduleHoliday struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#create-a-holiday
+func resourceZendeskScheduleHoliday() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a holiday of a business hours schedule.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createScheduleHoliday(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readScheduleHoliday(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateScheduleHoliday(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteScheduleHoliday(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := importScheduleHoliday(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Description: "The id of the schedule.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the holiday.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"start_date": {
+				Description:  "The first day of the holiday in `YYYY-MM-DD`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateScheduleHolidayDate,
+			},
+			"end_date": {
+				Description:  "The last day of the holiday in `YYYY-MM-DD`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateScheduleHolidayDate,
+			},
+		},
+	}
+}
+
+func validateScheduleHolidayDate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := time.Parse("2006-01-02", v); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a date in YYYY-MM-DD: %v", k, v)}
+	}
+
+	return nil, nil
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalScheduleHoliday(h scheduleHoliday, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":       h.Name,
+		"start_date": h.StartDate,
+		"end_date":   h.EndDate,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalScheduleHoliday(d identifiableGetterSetter) (int64, scheduleHoliday, error) {
+	h := scheduleHoliday{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return 0, h, fmt.Errorf("could not parse holiday id %s: %v", v, err)
+		}
+		h.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		h.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		h.StartDate = v.(string)
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		h.EndDate = v.(string)
+	}
+
+	return int64(d.Get("schedule_id").(int)), h, nil
+}
+
+func createScheduleHoliday(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scheduleID, h, err := unmarshalScheduleHoliday(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	path := fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID)
+	body, err := zd.Post(ctx, path, map[string]interface{}{"holiday": h})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	h, err = decodeScheduleHoliday(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", h.ID))
+
+	err = marshalScheduleHoliday(h, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readScheduleHoliday(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scheduleID, h, err := unmarshalScheduleHoliday(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, h.ID))
+	if isNotFound(err) {
+		return removeNotFound(d, "Holiday")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	h, err = decodeScheduleHoliday(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalScheduleHoliday(h, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateScheduleHoliday(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scheduleID, h, err := unmarshalScheduleHoliday(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	path := fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, h.ID)
+	body, err := zd.Put(ctx, path, map[string]interface{}{"holiday": h})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	h, err = decodeScheduleHoliday(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalScheduleHoliday(h, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteScheduleHoliday(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scheduleID, h, err := unmarshalScheduleHoliday(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, h.ID))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// importScheduleHoliday takes schedule_id:holiday_id since holidays are
+// only accessible through their schedule
+func importScheduleHoliday(d identifiableGetterSetter) error {
+	ids, err := parseCompositeID(d.Id(), "schedule_id", "holiday_id")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", ids[1]))
+	return d.Set("schedule_id", int(ids[0]))
+}
+
+func decodeScheduleHoliday(body []byte) (scheduleHoliday, error) {
+	var result struct {
+		Holiday scheduleHoliday `json:"holiday"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Holiday, err
+}
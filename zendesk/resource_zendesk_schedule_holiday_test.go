@@ -0,0 +1,135 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testScheduleHolidayResponse = `{
+  "holiday": {
+    "id": 5678,
+    "name": "New Year",
+    "start_date": "2027-01-01",
+    "end_date": "2027-01-03"
+  }
+}`
+
+func TestValidateScheduleHolidayDate(t *testing.T) {
+	if _, errs := validateScheduleHolidayDate("2027-01-01", "start_date"); len(errs) != 0 {
+		t.Fatalf("validateScheduleHolidayDate returned errors for a valid date: %v", errs)
+	}
+
+	for _, v := range []string{"2027-1-1", "2027-01-01T00:00:00Z", "2027-02-30"} {
+		if _, errs := validateScheduleHolidayDate(v, "start_date"); len(errs) == 0 {
+			t.Fatalf("validateScheduleHolidayDate accepted invalid date %s", v)
+		}
+	}
+}
+
+func TestCreateScheduleHoliday(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"schedule_id": 1234,
+			"name":        "New Year",
+			"start_date":  "2027-01-01",
+			"end_date":    "2027-01-03",
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/business_hours/schedules/1234/holidays.json"), Any()).Return([]byte(testScheduleHolidayResponse), nil)
+	if diags := createScheduleHoliday(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createScheduleHoliday returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "5678" {
+		t.Fatalf("createScheduleHoliday did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadScheduleHolidayNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "5678",
+		mapGetterSetter: mapGetterSetter{
+			"schedule_id": 1234,
+		},
+	}
+
+	m.EXPECT().Get(Any(), Eq("/business_hours/schedules/1234/holidays/5678.json")).Return(nil, newNotFoundError())
+	if diags := readScheduleHoliday(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readScheduleHoliday returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readScheduleHoliday did not remove holiday from state. Id was %s", v)
+	}
+}
+
+func TestUpdateScheduleHoliday(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "5678",
+		mapGetterSetter: mapGetterSetter{
+			"schedule_id": 1234,
+			"name":        "New Year",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/business_hours/schedules/1234/holidays/5678.json"), Any()).Return([]byte(testScheduleHolidayResponse), nil)
+	if diags := updateScheduleHoliday(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateScheduleHoliday returned an error: %v", diags)
+	}
+
+	if v := i.Get("end_date"); v != "2027-01-03" {
+		t.Fatalf("holiday had end_date %v. should have been 2027-01-03", v)
+	}
+}
+
+func TestDeleteScheduleHoliday(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "5678",
+		mapGetterSetter: mapGetterSetter{
+			"schedule_id": 1234,
+		},
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/business_hours/schedules/1234/holidays/5678.json")).Return(nil)
+	if diags := deleteScheduleHoliday(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteScheduleHoliday returned an error: %v", diags)
+	}
+}
+
+func TestImportScheduleHoliday(t *testing.T) {
+	i := &identifiableMapGetterSetter{
+		id:              "1234:5678",
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	if err := importScheduleHoliday(i); err != nil {
+		t.Fatalf("importScheduleHoliday returned an error: %v", err)
+	}
+
+	if v := i.Id(); v != "5678" {
+		t.Fatalf("importScheduleHoliday set id %s. should have been 5678", v)
+	}
+	if v := i.Get("schedule_id"); v != 1234 {
+		t.Fatalf("importScheduleHoliday set schedule_id %v. should have been 1234", v)
+	}
+}
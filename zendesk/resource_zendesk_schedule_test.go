@@ -0,0 +1,240 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testScheduleResponse = `{
+  "schedule": {
+    "id": 1234,
+    "name": "Business hours",
+    "time_zone": "Tokyo",
+    "intervals": [
+      {"start_time": 1980, "end_time": 2460},
+      {"start_time": 9180, "end_time": 10080}
+    ]
+  }
+}`
+
+func testScheduleIntervals(intervals ...map[string]interface{}) *schema.Set {
+	elem := resourceZendeskSchedule().Schema["interval"].Elem.(*schema.Resource)
+	list := make([]interface{}, 0, len(intervals))
+	for _, v := range intervals {
+		list = append(list, v)
+	}
+	return schema.NewSet(schema.HashResource(elem), list)
+}
+
+func TestMarshalSchedule(t *testing.T) {
+	s, err := decodeSchedule([]byte(testScheduleResponse))
+	if err != nil {
+		t.Fatalf("Failed to decode schedule %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	err = marshalSchedule(s, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	if v := m.Get("time_zone"); v != "Tokyo" {
+		t.Fatalf("schedule had time_zone %v. should have been Tokyo", v)
+	}
+
+	intervals := m.Get("interval").([]map[string]interface{})
+	expected := []map[string]interface{}{
+		{"day": "monday", "start_time": "09:00", "end_time": "17:00"},
+		{"day": "saturday", "start_time": "09:00", "end_time": "24:00"},
+	}
+	if len(intervals) != len(expected) {
+		t.Fatalf("schedule had %d intervals. should have been %d", len(intervals), len(expected))
+	}
+	for i, e := range expected {
+		for k, v := range e {
+			if intervals[i][k] != v {
+				t.Fatalf("schedule interval %d had %s %v. should have been %v", i, k, intervals[i][k], v)
+			}
+		}
+	}
+}
+
+func TestUnmarshalSchedule(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Business hours",
+			"time_zone": "Tokyo",
+			"interval": testScheduleIntervals(
+				map[string]interface{}{"day": "saturday", "start_time": "09:00", "end_time": "24:00"},
+				map[string]interface{}{"day": "monday", "start_time": "09:00", "end_time": "17:00"},
+			),
+		},
+	}
+
+	s, err := unmarshalSchedule(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if s.ID != 1234 || s.Name != "Business hours" || s.TimeZone != "Tokyo" {
+		t.Fatalf("schedule had incorrect values %v", s)
+	}
+
+	expected := []scheduleInterval{
+		{StartTime: 1980, EndTime: 2460},
+		{StartTime: 9180, EndTime: 10080},
+	}
+	if len(s.Intervals) != len(expected) {
+		t.Fatalf("schedule had %d intervals. should have been %d", len(s.Intervals), len(expected))
+	}
+	for i, e := range expected {
+		if s.Intervals[i] != e {
+			t.Fatalf("schedule interval %d was %v. should have been %v", i, s.Intervals[i], e)
+		}
+	}
+}
+
+func TestUnmarshalScheduleIntervalsInvalid(t *testing.T) {
+	cases := map[string][]interface{}{
+		"overlap": {
+			map[string]interface{}{"day": "monday", "start_time": "09:00", "end_time": "12:00"},
+			map[string]interface{}{"day": "monday", "start_time": "11:30", "end_time": "17:00"},
+		},
+		"are adjacent": {
+			map[string]interface{}{"day": "monday", "start_time": "09:00", "end_time": "12:00"},
+			map[string]interface{}{"day": "monday", "start_time": "12:00", "end_time": "17:00"},
+		},
+		"must start before it ends": {
+			map[string]interface{}{"day": "monday", "start_time": "17:00", "end_time": "09:00"},
+		},
+	}
+
+	for expected, list := range cases {
+		_, err := unmarshalScheduleIntervals(list)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected error containing %q. got %v", expected, err)
+		}
+	}
+}
+
+func TestCreateSchedule(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Business hours",
+			"time_zone": "Tokyo",
+			"interval": testScheduleIntervals(
+				map[string]interface{}{"day": "monday", "start_time": "09:00", "end_time": "17:00"},
+			),
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/business_hours/schedules.json"), Any()).Return([]byte(`{"schedule":{"id":1234}}`), nil)
+	m.EXPECT().Put(Any(), Eq("/business_hours/schedules/1234/workweek.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		expected := `{"workweek":{"intervals":[{"start_time":1980,"end_time":2460}]}}`
+		if string(b) != expected {
+			t.Fatalf("workweek request was %s. should have been %s", b, expected)
+		}
+
+		return []byte(`{}`), nil
+	})
+	m.EXPECT().Get(Any(), Eq("/business_hours/schedules/1234.json")).Return([]byte(testScheduleResponse), nil)
+
+	if diags := createSchedule(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createSchedule returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createSchedule did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadScheduleNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/business_hours/schedules/1234.json")).Return(nil, newNotFoundError())
+	if diags := readSchedule(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readSchedule returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readSchedule did not remove schedule from state. Id was %s", v)
+	}
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Business hours",
+			"time_zone": "Tokyo",
+			"interval": testScheduleIntervals(
+				map[string]interface{}{"day": "monday", "start_time": "09:00", "end_time": "17:00"},
+			),
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/business_hours/schedules/1234.json"), Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Put(Any(), Eq("/business_hours/schedules/1234/workweek.json"), Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Get(Any(), Eq("/business_hours/schedules/1234.json")).Return([]byte(testScheduleResponse), nil)
+
+	if diags := updateSchedule(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateSchedule returned an error: %v", diags)
+	}
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/business_hours/schedules/1234.json")).Return(nil)
+	if diags := deleteSchedule(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteSchedule returned an error: %v", diags)
+	}
+}
+
+func TestDeleteScheduleNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/business_hours/schedules/1234.json")).Return(newNotFoundError())
+	if diags := deleteSchedule(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteSchedule returned an error for a deleted schedule: %v", diags)
+	}
+}
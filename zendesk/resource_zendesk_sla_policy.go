@@ -239,7 +239,7 @@ func createSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.
 
 	sla, err = zd.CreateSLAPolicy(ctx, sla)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", sla.ID))
@@ -261,8 +261,11 @@ func readSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.SL
 	}
 
 	slaPolicy, err := zd.GetSLAPolicy(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "SLA policy")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalSLAPolicy(slaPolicy, d)
@@ -288,7 +291,7 @@ func updateSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.
 
 	slaPolicy, err = zd.UpdateSLAPolicy(ctx, id, slaPolicy)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalSLAPolicy(slaPolicy, d)
@@ -309,7 +312,7 @@ func deleteSLAPolicy(ctx context.Context, d identifiable, zd client.SLAPolicyAPI
 
 	err = zd.DeleteSLAPolicy(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -121,6 +122,27 @@ func TestReadSLAPolicy(t *testing.T) {
 	}
 }
 
+func TestReadSLAPolicyNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetSLAPolicy(gomock.Any(), gomock.Eq(int64(12345))).Return(zendesk.SLAPolicy{}, newNotFoundError())
+	diags := readSLAPolicy(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readSLAPolicy returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readSLAPolicy should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readSLAPolicy did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestUpdateSLAPolicy(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
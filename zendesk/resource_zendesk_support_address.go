@@ -0,0 +1,278 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type supportAddress struct {
+	ID                       int64  `json:"id,omitempty"`
+	Email                    string `json:"email"`
+	Name                     string `json:"name"`
+	BrandID                  int64  `json:"brand_id,omitempty"`
+	Default                  bool   `json:"default"`
+	ForwardingStatus         string `json:"forwarding_status,omitempty"`
+	SPFStatus                string `json:"spf_status,omitempty"`
+	CNAMEStatus              string `json:"cname_status,omitempty"`
+	DomainVerificationStatus string `json:"domain_verification_status,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+func resourceZendeskSupportAddress() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a support address resource. Support addresses are the email addresses that receive tickets for a brand. The default support address cannot be deleted.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createSupportAddress(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readSupportAddress(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateSupportAddress(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteSupportAddress(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The email address. Changing the email forces a new support address.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name displayed as the sender of emails from this address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"brand_id": {
+				Description: "The id of the brand of the support address. Defaults to the default brand.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"default": {
+				Description:      "If true, this is the address emails are sent from when no other support address applies. The account has a single default address, which can only be moved to another address, so false is ignored.",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressUnsetDefault,
+			},
+			"forwarding_status": {
+				Description: "Whether emails forwarded to Zendesk were received. One of `unknown`, `waiting`, `verified` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"spf_status": {
+				Description: "Whether the SPF record of the domain allows Zendesk to send emails. One of `unknown`, `verified` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cname_status": {
+				Description: "Whether the CNAME records of the domain are set up for Zendesk. One of `unknown`, `verified` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"domain_verification_status": {
+				Description: "Whether the domain verification TXT record is set up. One of `unknown`, `verified` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalSupportAddress(address supportAddress, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"email":                      address.Email,
+		"name":                       address.Name,
+		"brand_id":                   address.BrandID,
+		"default":                    address.Default,
+		"forwarding_status":          address.ForwardingStatus,
+		"spf_status":                 address.SPFStatus,
+		"cname_status":               address.CNAMEStatus,
+		"domain_verification_status": address.DomainVerificationStatus,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalSupportAddress(d identifiableGetterSetter) (supportAddress, error) {
+	address := supportAddress{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return address, fmt.Errorf("could not parse support address id %s: %v", v, err)
+		}
+		address.ID = id
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		address.Email = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		address.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("brand_id"); ok {
+		address.BrandID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("default"); ok {
+		address.Default = v.(bool)
+	}
+
+	return address, nil
+}
+
+func createSupportAddress(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	address, err := unmarshalSupportAddress(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/recipient_addresses.json", supportAddressPayload(address))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	address, err = decodeSupportAddress(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", address.ID))
+
+	err = marshalSupportAddress(address, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readSupportAddress(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/recipient_addresses/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "Support address")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	address, err := decodeSupportAddress(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalSupportAddress(address, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateSupportAddress(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	address, err := unmarshalSupportAddress(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/recipient_addresses/%d.json", address.ID), supportAddressPayload(address))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	address, err = decodeSupportAddress(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalSupportAddress(address, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteSupportAddress(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/recipient_addresses/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// supportAddressPayload leaves out the read-only statuses and the
+// attributes left to Zendesk
+func supportAddressPayload(address supportAddress) map[string]interface{} {
+	payload := map[string]interface{}{
+		"email": address.Email,
+	}
+
+	if address.Name != "" {
+		payload["name"] = address.Name
+	}
+
+	if address.BrandID != 0 {
+		payload["brand_id"] = address.BrandID
+	}
+
+	// Only the address becoming the default is sent since default can't be unset
+	if address.Default {
+		payload["default"] = true
+	}
+
+	return map[string]interface{}{"recipient_address": payload}
+}
+
+func decodeSupportAddress(body []byte) (supportAddress, error) {
+	var result struct {
+		RecipientAddress supportAddress `json:"recipient_address"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.RecipientAddress, err
+}
@@ -0,0 +1,101 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testSupportAddressResponse = `{
+  "recipient_address": {
+    "id": 1234,
+    "email": "billing@example.com",
+    "name": "Billing",
+    "brand_id": 56,
+    "default": false,
+    "forwarding_status": "waiting",
+    "spf_status": "failed",
+    "cname_status": "unknown",
+    "domain_verification_status": "verified"
+  }
+}`
+
+func TestSupportAddressPayload(t *testing.T) {
+	payload := supportAddressPayload(supportAddress{
+		Email:   "billing@example.com",
+		BrandID: 56,
+	})["recipient_address"].(map[string]interface{})
+
+	if payload["brand_id"] != int64(56) {
+		t.Fatalf("payload had incorrect brand_id %v", payload["brand_id"])
+	}
+	for _, k := range []string{"name", "default"} {
+		if _, ok := payload[k]; ok {
+			t.Fatalf("payload should not contain %s when unset. got %v", k, payload)
+		}
+	}
+}
+
+func TestCreateSupportAddress(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"email":    "billing@example.com",
+			"name":     "Billing",
+			"brand_id": 56,
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/recipient_addresses.json"), Any()).Return([]byte(testSupportAddressResponse), nil)
+	if diags := createSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createSupportAddress returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createSupportAddress did not set resource id. Id was %s", v)
+	}
+	if v := i.Get("spf_status"); v != "failed" {
+		t.Fatalf("createSupportAddress set spf_status %v. should have been failed", v)
+	}
+	if v := i.Get("forwarding_status"); v != "waiting" {
+		t.Fatalf("createSupportAddress set forwarding_status %v. should have been waiting", v)
+	}
+}
+
+func TestReadSupportAddressNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/recipient_addresses/1234.json")).Return(nil, newNotFoundError())
+	if diags := readSupportAddress(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readSupportAddress returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readSupportAddress did not remove support address from state. Id was %s", v)
+	}
+}
+
+func TestDeleteSupportAddress(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/recipient_addresses/1234.json")).Return(nil)
+	if diags := deleteSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteSupportAddress returned an error: %v", diags)
+	}
+}
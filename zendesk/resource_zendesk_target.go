@@ -13,7 +13,7 @@ import (
 // https://developer.zendesk.com/rest_api/docs/support/targets
 func resourceZendeskTarget() *schema.Resource {
 	return &schema.Resource{
-		Description: `Provides a target resource. (HTTP target is deprecated. See https://support.zendesk.com/hc/en-us/articles/4408826284698 for details.)`,
+		Description: `Provides a target resource. (HTTP target is deprecated, use zendesk_webhook instead. See https://support.zendesk.com/hc/en-us/articles/4408826284698 for details.)`,
 		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 			zd := meta.(*client.Client)
 			return createTarget(ctx, d, zd)
@@ -223,7 +223,7 @@ func createTarget(ctx context.Context, d identifiableGetterSetter, zd client.Tar
 	// Actual API request
 	target, err = zd.CreateTarget(ctx, target)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", target.ID))
@@ -245,8 +245,11 @@ func readTarget(ctx context.Context, d identifiableGetterSetter, zd client.Targe
 	}
 
 	target, err := zd.GetTarget(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Target")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTarget(target, d)
@@ -273,7 +276,7 @@ func updateTarget(ctx context.Context, d identifiableGetterSetter, zd client.Tar
 	// ActualAPI request
 	target, err = zd.UpdateTarget(ctx, id, target)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTarget(target, d)
@@ -294,7 +297,7 @@ func deleteTarget(ctx context.Context, d identifiable, zd client.TargetAPI) diag
 
 	err = zd.DeleteTarget(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/nukosuke/go-zendesk/zendesk"
 	"github.com/nukosuke/go-zendesk/zendesk/mock"
 )
@@ -158,6 +159,27 @@ func TestReadTarget(t *testing.T) {
 	}
 }
 
+func TestReadTargetNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetTarget(Any(), Eq(int64(12345))).Return(zendesk.Target{}, newNotFoundError())
+	diags := readTarget(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readTarget returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readTarget should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readTarget did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestCreateTarget(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -33,18 +33,10 @@ func resourceZendeskTicketField() *schema.Resource {
 				Description: "System or custom field type. Editable for custom field types and only on creation.",
 				Type:        schema.TypeString,
 				Required:    true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"checkbox",
-					"date",
-					"decimal",
-					"integer",
-					"multiselect",
+				ValidateFunc: validation.StringInSlice(append([]string{
 					"partialcreditcard",
-					"regexp",
 					"tagger",
-					"text",
-					"textarea",
-				}, false),
+				}, customFieldTypes...), false),
 			},
 			"title": {
 				Description: "The title of the ticket field.",
@@ -135,31 +127,7 @@ func resourceZendeskTicketField() *schema.Resource {
 				Computed: true,
 			},
 			// https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_fields/#updating-drop-down-field-options
-			"custom_field_option": {
-				Description: `Required and presented for a custom ticket field of type "multiselect" or "tagger".`,
-				Type:        schema.TypeSet,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Description: "Custom field option name.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"value": {
-							Description: "Custom field option value.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"id": {
-							Description: "Custom field option id.",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
-					},
-				},
-				Optional: true,
-				//TODO: empty is invalid form
-			},
+			"custom_field_option": customFieldOptionSchema(`Required and presented for a custom ticket field of type "multiselect" or "tagger".`),
 			// "priority" and "status" fields only
 			"sub_type_id": {
 				Description: `For system ticket fields of type "priority" and "status". Defaults to 0. A "priority" sub type of 1 removes the "Low" and "Urgent" options. A "status" sub type of 1 adds the "On-Hold" option.`,
@@ -182,6 +150,79 @@ func resourceZendeskTicketField() *schema.Resource {
 	}
 }
 
+// Custom field types shared by ticket, user and organization fields
+var customFieldTypes = []string{
+	"checkbox",
+	"date",
+	"decimal",
+	"integer",
+	"multiselect",
+	"regexp",
+	"text",
+	"textarea",
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_fields/#updating-drop-down-field-options
+func customFieldOptionSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Description: desc,
+		Type:        schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "Custom field option name.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"value": {
+					Description: "Custom field option value.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"id": {
+					Description: "Custom field option id.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+			},
+		},
+		Optional: true,
+		//TODO: empty is invalid form
+	}
+}
+
+func marshalCustomFieldOptions(options []client.CustomFieldOption) []map[string]interface{} {
+	customFieldOptions := make([]map[string]interface{}, 0)
+	for _, v := range options {
+		m := map[string]interface{}{
+			"name":  v.Name,
+			"value": v.Value,
+			"id":    v.ID,
+		}
+		customFieldOptions = append(customFieldOptions, m)
+	}
+
+	return customFieldOptions
+}
+
+func unmarshalCustomFieldOptions(options []interface{}) ([]client.CustomFieldOption, error) {
+	customFieldOptions := make([]client.CustomFieldOption, 0)
+	for _, o := range options {
+		option, ok := o.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected custom field option %v", o)
+		}
+
+		customFieldOptions = append(customFieldOptions, client.CustomFieldOption{
+			Name:  option["name"].(string),
+			Value: option["value"].(string),
+			ID:    int64(option["id"].(int)),
+		})
+	}
+
+	return customFieldOptions, nil
+}
+
 // marshalTicketField encodes the provided ticket field into the provided resource data
 func marshalTicketField(field client.TicketField, d identifiableGetterSetter) error {
 	fields := map[string]interface{}{
@@ -217,17 +258,7 @@ func marshalTicketField(field client.TicketField, d identifiableGetterSetter) er
 	fields["system_field_options"] = systemFieldOptions
 
 	// Set custom field options
-	customFieldOptions := make([]map[string]interface{}, 0)
-	for _, v := range field.CustomFieldOptions {
-		m := map[string]interface{}{
-			"name":  v.Name,
-			"value": v.Value,
-			"id":    v.ID,
-		}
-		customFieldOptions = append(customFieldOptions, m)
-	}
-
-	fields["custom_field_option"] = customFieldOptions
+	fields["custom_field_option"] = marshalCustomFieldOptions(field.CustomFieldOptions)
 
 	err := setSchemaFields(d, fields)
 	if err != nil {
@@ -317,19 +348,9 @@ func unmarshalTicketField(d identifiableGetterSetter) (client.TicketField, error
 	}
 
 	if v, ok := d.GetOk("custom_field_option"); ok {
-		options := v.(*schema.Set).List()
-		customFieldOptions := make([]client.CustomFieldOption, 0)
-		for _, o := range options {
-			option, ok := o.(map[string]interface{})
-			if !ok {
-				return tf, fmt.Errorf("could not parse custom options for field %v", tf)
-			}
-
-			customFieldOptions = append(customFieldOptions, client.CustomFieldOption{
-				Name:  option["name"].(string),
-				Value: option["value"].(string),
-				ID:    int64(option["id"].(int)),
-			})
+		customFieldOptions, err := unmarshalCustomFieldOptions(v.(*schema.Set).List())
+		if err != nil {
+			return tf, fmt.Errorf("could not parse custom options for field %v: %v", tf, err)
 		}
 
 		tf.CustomFieldOptions = customFieldOptions
@@ -372,7 +393,7 @@ func createTicketField(ctx context.Context, d identifiableGetterSetter, zd clien
 	// Actual API request
 	tf, err = zd.CreateTicketField(ctx, tf)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", tf.ID))
@@ -399,8 +420,11 @@ func readTicketField(ctx context.Context, d identifiableGetterSetter, zd client.
 	}
 
 	field, err := zd.GetTicketField(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Ticket field")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTicketField(field, d)
@@ -432,7 +456,7 @@ func updateTicketField(ctx context.Context, d identifiableGetterSetter, zd clien
 	// Actual API request
 	tf, err = zd.UpdateTicketField(ctx, id, tf)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTicketField(tf, d)
@@ -458,7 +482,7 @@ func deleteTicketField(ctx context.Context, d identifiable, zd client.TicketFiel
 
 	err = zd.DeleteTicketField(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
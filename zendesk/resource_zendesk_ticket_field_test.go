@@ -10,6 +10,8 @@ import (
 	"time"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -85,6 +87,27 @@ func TestReadTicketField(t *testing.T) {
 	}
 }
 
+func TestReadTicketFieldNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetTicketField(Any(), Eq(int64(12345))).Return(zendesk.TicketField{}, newNotFoundError())
+	diags := readTicketField(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readTicketField returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readTicketField should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readTicketField did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestDeleteTicketField(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -139,6 +162,27 @@ func TestCreateTicketField(t *testing.T) {
 	}
 }
 
+func TestCreateTicketFieldValidationError(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	err := newAPIError(http.StatusUnprocessableEntity, `{"error":"RecordInvalid","description":"Record validation errors","details":{"custom_field_options":[{"description":"Custom field options: value is invalid","error":"InvalidValue"}]}}`)
+	m.EXPECT().CreateTicketField(Any(), Any()).Return(zendesk.TicketField{}, err)
+
+	diags := createTicketField(context.Background(), i, m)
+	if len(diags) != 1 {
+		t.Fatalf("create ticket field should return a single diagnostic. got %v", diags)
+	}
+	if v := diags[0].AttributePath; !v.Equals(cty.GetAttrPath("custom_field_option")) {
+		t.Fatalf("diagnostic did not point at custom_field_option. got %#v", v)
+	}
+}
+
 func TestMarshalTicketField(t *testing.T) {
 	m := &identifiableMapGetterSetter{
 		id: "1234",
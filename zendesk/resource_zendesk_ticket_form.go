@@ -193,7 +193,7 @@ func createTicketForm(ctx context.Context, d identifiableGetterSetter, zd client
 	// Actual API request
 	tf, err = zd.CreateTicketForm(ctx, tf)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Patch from created resource
@@ -216,8 +216,11 @@ func readTicketForm(ctx context.Context, d identifiableGetterSetter, zd client.T
 	}
 
 	tf, err := zd.GetTicketForm(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Ticket form")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTicketForm(tf, d)
@@ -238,7 +241,7 @@ func updateTicketForm(ctx context.Context, d identifiableGetterSetter, zd client
 
 	tf, err = zd.UpdateTicketForm(ctx, tf.ID, tf)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTicketForm(tf, d)
@@ -259,7 +262,7 @@ func deleteTicketForm(ctx context.Context, d identifiable, zd client.TicketFormA
 
 	err = zd.DeleteTicketForm(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -70,6 +71,27 @@ func TestReadTicketForm(t *testing.T) {
 	}
 }
 
+func TestReadTicketFormNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(12345))).Return(zendesk.TicketForm{}, newNotFoundError())
+	diags := readTicketForm(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readTicketForm returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readTicketForm should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readTicketForm did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestUnmarshalTicketForm(t *testing.T) {
 
 	d := &identifiableMapGetterSetter{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -118,16 +119,22 @@ func marshalTrigger(trigger client.Trigger, d identifiableGetterSetter) error {
 
 		// If the trigger value is a string, leave it be
 		// If it's a list, marshal it to a string
+		// If it's a number such as a custom status ID, format it without exponent
 		var stringVal string
-		switch action.Value.(type) {
+		switch v := action.Value.(type) {
 		case []interface{}:
-			tmp, err := json.Marshal(action.Value)
+			tmp, err := json.Marshal(v)
 			if err != nil {
 				return fmt.Errorf("error decoding trigger action value: %s", err)
 			}
 			stringVal = string(tmp)
 		case string:
-			stringVal = action.Value.(string)
+			stringVal = v
+		case float64:
+			stringVal = strconv.FormatFloat(v, 'f', -1, 64)
+		case nil:
+		default:
+			stringVal = fmt.Sprintf("%v", v)
 		}
 
 		m := map[string]interface{}{
@@ -239,7 +246,7 @@ func createTrigger(ctx context.Context, d identifiableGetterSetter, zd client.Tr
 
 	trg, err = zd.CreateTrigger(ctx, trg)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", trg.ID))
@@ -261,8 +268,11 @@ func readTrigger(ctx context.Context, d identifiableGetterSetter, zd client.Trig
 	}
 
 	trigger, err := zd.GetTrigger(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "Trigger")
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTrigger(trigger, d)
@@ -288,7 +298,7 @@ func updateTrigger(ctx context.Context, d identifiableGetterSetter, zd client.Tr
 
 	trigger, err = zd.UpdateTrigger(ctx, id, trigger)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = marshalTrigger(trigger, d)
@@ -309,7 +319,7 @@ func deleteTrigger(ctx context.Context, d identifiable, zd client.TriggerAPI) di
 
 	err = zd.DeleteTrigger(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -53,6 +54,28 @@ func TestMarshalTrigger(t *testing.T) {
 	}
 }
 
+func TestMarshalTriggerNumericActionValue(t *testing.T) {
+	trg := zendesk.Trigger{
+		Title: "title",
+		Actions: []zendesk.TriggerAction{
+			{Field: "custom_status_id", Value: float64(10000000000000)},
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err := marshalTrigger(trg, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	actions := m.Get("action").([]map[string]interface{})
+	if v := actions[0]["value"]; v != "10000000000000" {
+		t.Fatalf("trigger had action value %v. should have been 10000000000000", v)
+	}
+}
+
 func TestUnmarshalTrigger(t *testing.T) {
 	m := &identifiableMapGetterSetter{
 		id: "100",
@@ -116,6 +139,27 @@ func TestReadTrigger(t *testing.T) {
 	}
 }
 
+func TestReadTriggerNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().GetTrigger(gomock.Any(), gomock.Eq(int64(12345))).Return(zendesk.Trigger{}, newNotFoundError())
+	diags := readTrigger(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readTrigger returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readTrigger should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readTrigger did not remove resource from state. Id was %s", v)
+	}
+}
+
 func TestUpdateTrigger(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
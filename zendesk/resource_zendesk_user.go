@@ -0,0 +1,383 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// userAPI adds the raw requests needed for delete and unsuspend,
+// which go-zendesk UserAPI does not cover
+type userAPI interface {
+	client.UserAPI
+	client.BaseAPI
+}
+
+// userPayload always sends suspended since go-zendesk omits false,
+// which would make it impossible to unsuspend a user
+type userPayload struct {
+	client.User
+	Suspended bool `json:"suspended"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/users/users/
+func resourceZendeskUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a user resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createUser(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readUser(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateUser(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteUser(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				zd := meta.(*client.Client)
+				if err := importUser(ctx, d, zd); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "The API url of this user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The user's name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"email": {
+				Description: "The user's primary email address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description: "The user's role. Possible values are `end-user`, `agent`, or `admin`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "end-user",
+				ValidateFunc: validation.StringInSlice([]string{
+					"end-user",
+					"agent",
+					"admin",
+				}, false),
+			},
+			"custom_role_id": {
+				Description: "A custom role if the user is an agent on the Enterprise plan or above.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_group_id": {
+				Description: "The id of the user's default group. Only for agents.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_id": {
+				Description: "The id of the user's organization. If the user has more than one organization memberships, the id of the user's default organization.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tags": {
+				Description: "The user's tags.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"user_fields": {
+				Description: "Values of custom user fields keyed by field key. Only the configured fields are managed, so other fields and fields removed from the map keep their values.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"suspended": {
+				Description: "If the agent is suspended. Tickets from suspended users are also suspended, and these users cannot sign in to the end user portal.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"time_zone": {
+				Description: "The user's time zone, e.g. `Eastern Time (US & Canada)`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"locale": {
+				Description: "The user's locale, e.g. `en-US`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Description: "false if the user has been deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// marshalCustomFieldValues keeps the custom field values of the keys in
+// the configured map, so fields not managed by terraform never show up in plans
+func marshalCustomFieldValues(values map[string]interface{}, configured interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+
+	keys, _ := configured.(map[string]interface{})
+	for k := range keys {
+		if v, ok := values[k]; ok && v != nil {
+			out[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return out
+}
+
+func marshalUser(user client.User, d identifiableGetterSetter) error {
+	userFields := marshalCustomFieldValues(user.UserFields, d.Get("user_fields"))
+
+	fields := map[string]interface{}{
+		"url":              user.URL,
+		"name":             user.Name,
+		"email":            user.Email,
+		"role":             user.Role,
+		"custom_role_id":   user.CustomRoleID,
+		"default_group_id": user.DefaultGroupID,
+		"organization_id":  user.OrganizationID,
+		"tags":             user.Tags,
+		"user_fields":      userFields,
+		"suspended":        user.Suspended,
+		"time_zone":        user.Timezone,
+		"locale":           user.Locale,
+		"active":           user.Active,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalUser(d identifiableGetterSetter) (client.User, error) {
+	user := client.User{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return user, fmt.Errorf("could not parse user id %s: %v", v, err)
+		}
+		user.ID = id
+	}
+
+	if v, ok := d.GetOk("url"); ok {
+		user.URL = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		user.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		user.Email = v.(string)
+	}
+
+	if v, ok := d.GetOk("role"); ok {
+		user.Role = v.(string)
+	}
+
+	if v, ok := d.GetOk("custom_role_id"); ok {
+		user.CustomRoleID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("default_group_id"); ok {
+		user.DefaultGroupID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("organization_id"); ok {
+		user.OrganizationID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		tags := v.(*schema.Set).List()
+		for _, tag := range tags {
+			user.Tags = append(user.Tags, tag.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("user_fields"); ok {
+		user.UserFields = client.UserFields{}
+		for key, value := range v.(map[string]interface{}) {
+			user.UserFields[key] = value
+		}
+	}
+
+	if v, ok := d.GetOk("suspended"); ok {
+		user.Suspended = v.(bool)
+	}
+
+	if v, ok := d.GetOk("time_zone"); ok {
+		user.Timezone = v.(string)
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		user.Locale = v.(string)
+	}
+
+	return user, nil
+}
+
+func createUser(ctx context.Context, d identifiableGetterSetter, zd userAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	user, err := unmarshalUser(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	user, err = zd.CreateUser(ctx, user)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", user.ID))
+
+	err = marshalUser(user, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readUser(ctx context.Context, d identifiableGetterSetter, zd userAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := zd.GetUser(ctx, id)
+	if isNotFound(err) {
+		return removeNotFound(d, "User")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// Soft deleted users are still returned by the API
+	if !user.Active {
+		return removeNotFound(d, "User")
+	}
+
+	err = marshalUser(user, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateUser(ctx context.Context, d identifiableGetterSetter, zd userAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	user, err := unmarshalUser(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	payload := map[string]interface{}{
+		"user": userPayload{User: user, Suspended: user.Suspended},
+	}
+	body, err := zd.Put(ctx, fmt.Sprintf("/users/%d.json", id), payload)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var result struct {
+		User client.User `json:"user"`
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalUser(result.User, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// deleteUser soft deletes the user. Zendesk keeps deleted users with
+// active=false until they are permanently deleted by an admin.
+func deleteUser(ctx context.Context, d identifiable, zd userAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/users/%d.json", id))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// importUser accepts either the user id or the primary email address
+func importUser(ctx context.Context, d identifiable, zd userAPI) error {
+	email := d.Id()
+	if _, err := atoi64(email); err == nil {
+		return nil
+	}
+
+	users, _, err := zd.SearchUsers(ctx, &client.SearchUsersOptions{
+		Query: fmt.Sprintf("email:%s", email),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			d.SetId(fmt.Sprintf("%d", user.ID))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("could not find user with email %s", email)
+}
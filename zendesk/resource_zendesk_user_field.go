@@ -0,0 +1,406 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// customField is a user or organization field. The types of go-zendesk
+// omit active when false and only support listing and creating fields.
+type customField struct {
+	ID                     int64                      `json:"id,omitempty"`
+	URL                    string                     `json:"url,omitempty"`
+	Key                    string                     `json:"key,omitempty"`
+	Type                   string                     `json:"type"`
+	Title                  string                     `json:"title"`
+	Description            string                     `json:"description"`
+	Position               int64                      `json:"position,omitempty"`
+	Active                 bool                       `json:"active"`
+	RegexpForValidation    string                     `json:"regexp_for_validation,omitempty"`
+	Tag                    string                     `json:"tag,omitempty"`
+	RelationshipTargetType string                     `json:"relationship_target_type,omitempty"`
+	CustomFieldOptions     []client.CustomFieldOption `json:"custom_field_options,omitempty"`
+}
+
+// customFieldKind distinguishes the endpoints of user and organization fields
+type customFieldKind struct {
+	name string
+	key  string
+}
+
+var userFieldKind = customFieldKind{
+	name: "User field",
+	key:  "user_field",
+}
+
+func (k customFieldKind) path(id interface{}) string {
+	return fmt.Sprintf("/%ss/%v.json", k.key, id)
+}
+
+var customFieldKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_]*[A-Za-z_][A-Za-z0-9_]*$`)
+
+var customFieldRelationshipTargetTypeRegexp = regexp.MustCompile(`^zen:(user|organization|ticket|custom_object:\w+)$`)
+
+// https://developer.zendesk.com/api-reference/ticketing/users/user_fields/
+func resourceZendeskUserField() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a custom user field resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createUserField(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readUserField(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateUserField(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteUserField(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				zd := meta.(*client.Client)
+				if err := importCustomField(ctx, d, zd, userFieldKind); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: validateCustomFieldDiff,
+
+		Schema: customFieldSchema("user"),
+	}
+}
+
+func customFieldSchema(target string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"url": {
+			Description: fmt.Sprintf("The URL for this %s field.", target),
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"key": {
+			Description:  fmt.Sprintf("A unique key that identifies this %s field. It is used to reference the field in placeholders and in the `%s_fields` of %ss. Changing the key forces a new field.", target, target, target),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(customFieldKeyRegexp, "must consist of letters, numbers and underscores and not only of numbers"),
+		},
+		"type": {
+			Description: "The custom field type. Changing the type forces a new field.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.StringInSlice(append([]string{
+				"dropdown",
+				"lookup",
+			}, customFieldTypes...), false),
+		},
+		"title": {
+			Description: fmt.Sprintf("The title of the %s field.", target),
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: fmt.Sprintf("Describes the purpose of the %s field to users.", target),
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"position": {
+			Description: fmt.Sprintf("The relative position of the %s field.", target),
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"active": {
+			Description: "Whether this field is available.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"regexp_for_validation": {
+			Description: `Required for "regexp" fields only. The validation pattern for a field value to be deemed valid.`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"tag": {
+			Description: fmt.Sprintf(`For "checkbox" fields only. A tag added to %ss when the checkbox field is selected.`, target),
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"relationship_target_type": {
+			Description:  `Required for "lookup" fields only. The type of object the field references, e.g. "zen:user", "zen:organization", "zen:ticket" or "zen:custom_object:KEY". Changing it forces a new field.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(customFieldRelationshipTargetTypeRegexp, "must be a Zendesk object type such as zen:user"),
+		},
+		"custom_field_option": customFieldOptionSchema(`Required and presented for a custom field of type "dropdown" or "multiselect".`),
+	}
+}
+
+// validateCustomFieldDiff checks the attributes which are required by the field type
+func validateCustomFieldDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	fieldType := d.Get("type").(string)
+	switch fieldType {
+	case "dropdown", "multiselect":
+		if d.NewValueKnown("custom_field_option") && d.Get("custom_field_option").(*schema.Set).Len() == 0 {
+			return fmt.Errorf("custom_field_option is required for %s fields", fieldType)
+		}
+	case "regexp":
+		if d.NewValueKnown("regexp_for_validation") && d.Get("regexp_for_validation").(string) == "" {
+			return fmt.Errorf("regexp_for_validation is required for regexp fields")
+		}
+	case "lookup":
+		if d.NewValueKnown("relationship_target_type") && d.Get("relationship_target_type").(string) == "" {
+			return fmt.Errorf("relationship_target_type is required for lookup fields")
+		}
+	}
+
+	return nil
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalCustomField(field customField, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":                      field.URL,
+		"key":                      field.Key,
+		"type":                     field.Type,
+		"title":                    field.Title,
+		"description":              field.Description,
+		"position":                 field.Position,
+		"active":                   field.Active,
+		"regexp_for_validation":    field.RegexpForValidation,
+		"tag":                      field.Tag,
+		"relationship_target_type": field.RelationshipTargetType,
+		"custom_field_option":      marshalCustomFieldOptions(field.CustomFieldOptions),
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalCustomField(d identifiableGetterSetter) (customField, error) {
+	field := customField{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return field, fmt.Errorf("could not parse field id %s: %v", v, err)
+		}
+		field.ID = id
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		field.Key = v.(string)
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		field.Type = v.(string)
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		field.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		field.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		field.Position = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("active"); ok {
+		field.Active = v.(bool)
+	}
+
+	if v, ok := d.GetOk("regexp_for_validation"); ok {
+		field.RegexpForValidation = v.(string)
+	}
+
+	if v, ok := d.GetOk("tag"); ok {
+		field.Tag = v.(string)
+	}
+
+	if v, ok := d.GetOk("relationship_target_type"); ok {
+		field.RelationshipTargetType = v.(string)
+	}
+
+	if v, ok := d.GetOk("custom_field_option"); ok {
+		options, err := unmarshalCustomFieldOptions(v.(*schema.Set).List())
+		if err != nil {
+			return field, fmt.Errorf("could not parse custom options for field %v: %v", field.Key, err)
+		}
+		field.CustomFieldOptions = options
+	}
+
+	return field, nil
+}
+
+func createUserField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	return createCustomField(ctx, d, zd, userFieldKind)
+}
+
+func readUserField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	return readCustomField(ctx, d, zd, userFieldKind)
+}
+
+func updateUserField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	return updateCustomField(ctx, d, zd, userFieldKind)
+}
+
+func deleteUserField(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	return deleteCustomField(ctx, d, zd, userFieldKind)
+}
+
+func createCustomField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI, kind customFieldKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	field, err := unmarshalCustomField(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, fmt.Sprintf("/%ss.json", kind.key), map[string]interface{}{kind.key: field})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	field, err = decodeCustomField(body, kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", field.ID))
+
+	err = marshalCustomField(field, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI, kind customFieldKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, kind.path(id))
+	if isNotFound(err) {
+		return removeNotFound(d, kind.name)
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	field, err := decodeCustomField(body, kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomField(field, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateCustomField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI, kind customFieldKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	field, err := unmarshalCustomField(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, kind.path(field.ID), map[string]interface{}{kind.key: field})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	field, err = decodeCustomField(body, kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomField(field, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteCustomField(ctx context.Context, d identifiable, zd client.BaseAPI, kind customFieldKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, kind.path(id))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// importCustomField accepts either the ID or the key of a field since the
+// API looks fields up by both
+func importCustomField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI, kind customFieldKind) error {
+	body, err := zd.Get(ctx, kind.path(d.Id()))
+	if err != nil {
+		return fmt.Errorf("could not find %s %s: %v", kind.key, d.Id(), err)
+	}
+
+	field, err := decodeCustomField(body, kind)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", field.ID))
+	return marshalCustomField(field, d)
+}
+
+func decodeCustomField(body []byte, kind customFieldKind) (customField, error) {
+	var field customField
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return field, err
+	}
+
+	raw, ok := result[kind.key]
+	if !ok {
+		return field, fmt.Errorf("response did not contain %s", kind.key)
+	}
+
+	err := json.Unmarshal(raw, &field)
+	return field, err
+}
@@ -0,0 +1,199 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testUserFieldResponse = `{
+  "user_field": {
+    "id": 1234,
+    "url": "https://example.zendesk.com/api/v2/user_fields/1234.json",
+    "key": "support_tier",
+    "type": "dropdown",
+    "title": "Support tier",
+    "active": true,
+    "custom_field_options": [
+      {"id": 11, "name": "Gold", "value": "gold"},
+      {"id": 12, "name": "Silver", "value": "silver"}
+    ]
+  }
+}`
+
+func testCustomFieldOptions(options ...map[string]interface{}) *schema.Set {
+	elem := customFieldOptionSchema("").Elem.(*schema.Resource)
+	list := make([]interface{}, 0, len(options))
+	for _, v := range options {
+		list = append(list, v)
+	}
+	return schema.NewSet(schema.HashResource(elem), list)
+}
+
+func TestMarshalCustomField(t *testing.T) {
+	field, err := decodeCustomField([]byte(testUserFieldResponse), userFieldKind)
+	if err != nil {
+		t.Fatalf("Failed to decode field %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	err = marshalCustomField(field, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	if v := m.Get("key"); v != "support_tier" {
+		t.Fatalf("field had key %v. should have been support_tier", v)
+	}
+
+	options := m.Get("custom_field_option").([]map[string]interface{})
+	if len(options) != 2 || options[0]["value"] != "gold" || options[0]["id"] != int64(11) {
+		t.Fatalf("field had incorrect custom field options %v", options)
+	}
+}
+
+func TestUnmarshalCustomField(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"key":   "support_tier",
+			"type":  "dropdown",
+			"title": "Support tier",
+			"custom_field_option": testCustomFieldOptions(
+				map[string]interface{}{"name": "Gold", "value": "gold", "id": 11},
+			),
+		},
+	}
+
+	field, err := unmarshalCustomField(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if field.ID != 1234 || field.Key != "support_tier" || field.Type != "dropdown" {
+		t.Fatalf("field had incorrect values %v", field)
+	}
+	if len(field.CustomFieldOptions) != 1 || field.CustomFieldOptions[0].ID != 11 {
+		t.Fatalf("field had incorrect custom field options %v", field.CustomFieldOptions)
+	}
+}
+
+func TestCreateUserField(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"key":    "support_tier",
+			"type":   "text",
+			"title":  "Support tier",
+			"active": false,
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/user_fields.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			UserField map[string]interface{} `json:"user_field"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if v, ok := req.UserField["active"]; !ok || v != false {
+			t.Fatalf("create request should send active=false. got %s", b)
+		}
+
+		return []byte(testUserFieldResponse), nil
+	})
+	if diags := createUserField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createUserField returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createUserField did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadUserFieldNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/user_fields/1234.json")).Return(nil, newNotFoundError())
+	if diags := readUserField(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readUserField returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readUserField did not remove user field from state. Id was %s", v)
+	}
+}
+
+func TestUpdateUserField(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"key":   "support_tier",
+			"type":  "dropdown",
+			"title": "Support tier",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/user_fields/1234.json"), Any()).Return([]byte(testUserFieldResponse), nil)
+	if diags := updateUserField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateUserField returned an error: %v", diags)
+	}
+}
+
+func TestDeleteUserField(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/user_fields/1234.json")).Return(nil)
+	if diags := deleteUserField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteUserField returned an error: %v", diags)
+	}
+}
+
+func TestImportUserFieldByKey(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id:              "support_tier",
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	m.EXPECT().Get(Any(), Eq("/user_fields/support_tier.json")).Return([]byte(testUserFieldResponse), nil)
+	if err := importCustomField(context.Background(), i, m, userFieldKind); err != nil {
+		t.Fatalf("importCustomField returned an error: %v", err)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("importCustomField did not set resource id. Id was %s", v)
+	}
+}
@@ -0,0 +1,281 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+type userSegment struct {
+	ID              int64    `json:"id,omitempty"`
+	Name            string   `json:"name"`
+	UserType        string   `json:"user_type"`
+	GroupIDs        []int64  `json:"group_ids"`
+	OrganizationIDs []int64  `json:"organization_ids"`
+	Tags            []string `json:"tags"`
+	OrTags          []string `json:"or_tags"`
+	BuiltIn         bool     `json:"built_in,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/
+func resourceZendeskUserSegment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center user segment resource. User segments restrict who can view articles and topics.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createUserSegment(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readUserSegment(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateUserSegment(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteUserSegment(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the user segment.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"user_type": {
+				Description: "The set of users who can view content. `signed_in_users` includes end users and agents, `staff` only agents and admins.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"signed_in_users",
+					"staff",
+				}, false),
+			},
+			"group_ids": {
+				Description: "The ids of the groups that have access.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"organization_ids": {
+				Description: "The ids of the organizations that have access.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"tags": {
+				Description: "Users must have all of these tags to have access.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"or_tags": {
+				Description: "Users must have at least one of these tags to have access.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"built_in": {
+				Description: "Whether the user segment is built-in. Built-in user segments cannot be modified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalUserSegment(segment userSegment, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":             segment.Name,
+		"user_type":        segment.UserType,
+		"group_ids":        segment.GroupIDs,
+		"organization_ids": segment.OrganizationIDs,
+		"tags":             segment.Tags,
+		"or_tags":          segment.OrTags,
+		"built_in":         segment.BuiltIn,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalUserSegment(d identifiableGetterSetter) (userSegment, error) {
+	segment := userSegment{
+		GroupIDs:        []int64{},
+		OrganizationIDs: []int64{},
+		Tags:            []string{},
+		OrTags:          []string{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return segment, fmt.Errorf("could not parse user segment id %s: %v", v, err)
+		}
+		segment.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		segment.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		segment.UserType = v.(string)
+	}
+
+	if v, ok := d.GetOk("group_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			segment.GroupIDs = append(segment.GroupIDs, int64(id.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("organization_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			segment.OrganizationIDs = append(segment.OrganizationIDs, int64(id.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		for _, tag := range v.(*schema.Set).List() {
+			segment.Tags = append(segment.Tags, tag.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("or_tags"); ok {
+		for _, tag := range v.(*schema.Set).List() {
+			segment.OrTags = append(segment.OrTags, tag.(string))
+		}
+	}
+
+	return segment, nil
+}
+
+func createUserSegment(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	segment, err := unmarshalUserSegment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/help_center/user_segments.json", map[string]interface{}{"user_segment": segment})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	segment, err = decodeUserSegment(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", segment.ID))
+
+	err = marshalUserSegment(segment, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readUserSegment(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "User segment")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	segment, err := decodeUserSegment(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalUserSegment(segment, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateUserSegment(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	segment, err := unmarshalUserSegment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", segment.ID), map[string]interface{}{"user_segment": segment})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	segment, err = decodeUserSegment(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalUserSegment(segment, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteUserSegment(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeUserSegment(body []byte) (userSegment, error) {
+	var result struct {
+		UserSegment userSegment `json:"user_segment"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.UserSegment, err
+}
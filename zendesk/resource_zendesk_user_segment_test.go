@@ -0,0 +1,97 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalUserSegment(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"name":      "VIP customers",
+			"user_type": "signed_in_users",
+			"group_ids": schema.NewSet(schema.HashInt, []interface{}{56}),
+			"or_tags":   schema.NewSet(schema.HashString, []interface{}{"vip", "enterprise"}),
+		},
+	}
+
+	segment, err := unmarshalUserSegment(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if segment.ID != 1234 || segment.Name != "VIP customers" || segment.UserType != "signed_in_users" {
+		t.Fatalf("user segment had incorrect values %v", segment)
+	}
+	if len(segment.GroupIDs) != 1 || segment.GroupIDs[0] != 56 {
+		t.Fatalf("user segment had incorrect group ids %v", segment.GroupIDs)
+	}
+	if len(segment.OrTags) != 2 {
+		t.Fatalf("user segment had incorrect or_tags %v", segment.OrTags)
+	}
+	// Unset lists must be sent as empty to clear them on update
+	if segment.OrganizationIDs == nil || segment.Tags == nil {
+		t.Fatalf("user segment should have empty organization ids and tags. got %v and %v", segment.OrganizationIDs, segment.Tags)
+	}
+}
+
+func TestCreateUserSegment(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Agents",
+			"user_type": "staff",
+		},
+	}
+
+	m.EXPECT().Post(Any(), Eq("/help_center/user_segments.json"), Any()).
+		Return([]byte(`{"user_segment":{"id":1234,"name":"Agents","user_type":"staff","group_ids":[],"organization_ids":[],"tags":[],"or_tags":[],"built_in":false}}`), nil)
+	if diags := createUserSegment(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createUserSegment returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createUserSegment did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadUserSegmentNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(Any(), Eq("/help_center/user_segments/1234.json")).Return(nil, newNotFoundError())
+	if diags := readUserSegment(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readUserSegment returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readUserSegment did not remove user segment from state. Id was %s", v)
+	}
+}
+
+func TestDeleteUserSegment(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/help_center/user_segments/1234.json")).Return(nil)
+	if diags := deleteUserSegment(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteUserSegment returned an error: %v", diags)
+	}
+}
@@ -0,0 +1,362 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalUser(t *testing.T) {
+	expected := zendesk.User{
+		URL:            "https://example.zendesk.com/api/v2/users/1234.json",
+		Name:           "John Doe",
+		Email:          "john.doe@example.com",
+		Role:           "agent",
+		CustomRoleID:   10,
+		DefaultGroupID: 20,
+		OrganizationID: 30,
+		Suspended:      true,
+		Timezone:       "Tokyo",
+		Locale:         "ja",
+		Active:         true,
+		UserFields: zendesk.UserFields{
+			"employee_id": "E123",
+			"level":       float64(3),
+			"vip":         true,
+			"empty":       nil,
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"user_fields": map[string]interface{}{"employee_id": "E000", "level": "1", "empty": "x"},
+		},
+	}
+
+	err := marshalUser(expected, m)
+	if err != nil {
+		t.Fatalf("Could not marshal map %v", err)
+	}
+
+	cases := map[string]interface{}{
+		"url":              expected.URL,
+		"name":             expected.Name,
+		"email":            expected.Email,
+		"role":             expected.Role,
+		"custom_role_id":   expected.CustomRoleID,
+		"default_group_id": expected.DefaultGroupID,
+		"organization_id":  expected.OrganizationID,
+		"suspended":        expected.Suspended,
+		"time_zone":        expected.Timezone,
+		"locale":           expected.Locale,
+		"active":           expected.Active,
+	}
+	for k, expectedValue := range cases {
+		v, ok := m.GetOk(k)
+		if !ok {
+			t.Fatalf("Failed to get %s value", k)
+		}
+		if v != expectedValue {
+			t.Fatalf("user had incorrect %s value %v. should have been %v", k, v, expectedValue)
+		}
+	}
+
+	userFields := m.Get("user_fields").(map[string]interface{})
+	// Only configured fields are kept
+	expectedFields := map[string]interface{}{"employee_id": "E123", "level": "3"}
+	if len(userFields) != len(expectedFields) {
+		t.Fatalf("user had user_fields %v. should have been %v", userFields, expectedFields)
+	}
+	for k, v := range expectedFields {
+		if userFields[k] != v {
+			t.Fatalf("user had user_fields.%s value %v. should have been %v", k, userFields[k], v)
+		}
+	}
+}
+
+func TestReadUserSubsetOfUserFieldsHasNoDiff(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceZendeskUser()
+	raw := map[string]interface{}{
+		"name":        "John Doe",
+		"email":       "john.doe@example.com",
+		"role":        "end-user",
+		"time_zone":   "Tokyo",
+		"locale":      "ja",
+		"user_fields": map[string]interface{}{"employee_id": "E123"},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("1234")
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetUser(Any(), Eq(int64(1234))).Return(zendesk.User{
+		ID:       1234,
+		Name:     "John Doe",
+		Email:    "john.doe@example.com",
+		Role:     "end-user",
+		Timezone: "Tokyo",
+		Locale:   "ja",
+		Active:   true,
+		UserFields: zendesk.UserFields{
+			"employee_id": "E123",
+			"vip":         false,
+			"level":       float64(3),
+		},
+	}, nil)
+
+	if diags := readUser(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("readUser returned an error: %v", diags)
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("Diff returned an error: %v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("plan after read should be empty. got %v", diff.Attributes)
+	}
+}
+
+func TestUnmarshalUser(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"url":              "https://example.zendesk.com/api/v2/users/1234.json",
+			"name":             "John Doe",
+			"email":            "john.doe@example.com",
+			"role":             "agent",
+			"custom_role_id":   10,
+			"default_group_id": 20,
+			"organization_id":  30,
+			"tags":             schema.NewSet(schema.HashString, []interface{}{"vip"}),
+			"user_fields":      map[string]interface{}{"employee_id": "E123"},
+			"suspended":        true,
+			"time_zone":        "Tokyo",
+			"locale":           "ja",
+		},
+	}
+
+	u, err := unmarshalUser(m)
+	if err != nil {
+		t.Fatalf("Could not unmarshal map %v", err)
+	}
+
+	if u.ID != 1234 {
+		t.Fatalf("user had id value %v. should have been 1234", u.ID)
+	}
+	if v := m.Get("name"); u.Name != v {
+		t.Fatalf("user had name value %v. should have been %v", u.Name, v)
+	}
+	if v := m.Get("email"); u.Email != v {
+		t.Fatalf("user had email value %v. should have been %v", u.Email, v)
+	}
+	if v := m.Get("role"); u.Role != v {
+		t.Fatalf("user had role value %v. should have been %v", u.Role, v)
+	}
+	if u.CustomRoleID != 10 || u.DefaultGroupID != 20 || u.OrganizationID != 30 {
+		t.Fatalf("user had incorrect ids %v, %v, %v", u.CustomRoleID, u.DefaultGroupID, u.OrganizationID)
+	}
+	if len(u.Tags) != 1 || u.Tags[0] != "vip" {
+		t.Fatalf("user had tags value %v. should have been [vip]", u.Tags)
+	}
+	if v := u.UserFields["employee_id"]; v != "E123" {
+		t.Fatalf("user had user_fields.employee_id value %v. should have been E123", v)
+	}
+	if !u.Suspended {
+		t.Fatalf("user was not suspended")
+	}
+	if u.Timezone != "Tokyo" || u.Locale != "ja" {
+		t.Fatalf("user had time_zone %v and locale %v", u.Timezone, u.Locale)
+	}
+}
+
+func TestReadUser(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	id := 1234
+	gs := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+		id:              strconv.Itoa(id),
+	}
+
+	user := zendesk.User{
+		ID:     int64(id),
+		Name:   "John Doe",
+		Email:  "john.doe@example.com",
+		Active: true,
+	}
+
+	m.EXPECT().GetUser(Any(), Eq(int64(id))).Return(user, nil)
+	if diags := readUser(context.Background(), gs, m); len(diags) != 0 {
+		t.Fatalf("readUser returned an error: %v", diags)
+	}
+
+	if v := gs.mapGetterSetter["name"]; v != user.Name {
+		t.Fatalf("name field %v does not have expected value %v", v, user.Name)
+	}
+
+	if v := gs.mapGetterSetter["email"]; v != user.Email {
+		t.Fatalf("email field %v does not have expected value %v", v, user.Email)
+	}
+}
+
+func TestReadUserDeleted(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().GetUser(Any(), Eq(int64(1234))).Return(zendesk.User{ID: 1234, Active: false}, nil)
+	if diags := readUser(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readUser returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readUser did not remove soft deleted user from state. Id was %s", v)
+	}
+}
+
+func TestReadUserNotFound(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().GetUser(Any(), Eq(int64(1234))).Return(zendesk.User{}, newNotFoundError())
+	if diags := readUser(context.Background(), i, m); diags.HasError() {
+		t.Fatalf("readUser returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readUser did not remove user from state. Id was %s", v)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: make(mapGetterSetter),
+	}
+
+	out := zendesk.User{
+		ID: 12345,
+	}
+
+	m.EXPECT().CreateUser(Any(), Any()).Return(out, nil)
+	if diags := createUser(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("create user returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("Create did not set resource id. Id was %s", v)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "12345",
+		mapGetterSetter: mapGetterSetter{
+			"name":      "John Doe",
+			"suspended": false,
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/users/12345.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			User map[string]interface{} `json:"user"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if v, ok := req.User["suspended"]; !ok || v != false {
+			t.Fatalf("update request did not send suspended=false: %s", b)
+		}
+
+		return []byte(`{"user":{"id":12345,"name":"John Doe","active":true}}`), nil
+	})
+	if diags := updateUser(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateUser returned an error: %v", diags)
+	}
+
+	if v := i.Get("name"); v != "John Doe" {
+		t.Fatalf("updateUser did not marshal response. name was %v", v)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "12345",
+	}
+
+	m.EXPECT().Delete(Any(), Eq("/users/12345.json")).Return(nil)
+	if diags := deleteUser(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteUser returned an error: %v", diags)
+	}
+}
+
+func TestImportUser(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+	if err := importUser(context.Background(), i, m); err != nil {
+		t.Fatalf("importUser returned an error for id: %v", err)
+	}
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("importUser changed id to %s", v)
+	}
+
+	users := []zendesk.User{
+		{ID: 1, Email: "john.doe+other@example.com"},
+		{ID: 2, Email: "John.Doe@example.com"},
+	}
+	m.EXPECT().SearchUsers(Any(), Any()).Return(users, zendesk.Page{}, nil)
+
+	i.SetId("john.doe@example.com")
+	if err := importUser(context.Background(), i, m); err != nil {
+		t.Fatalf("importUser returned an error for email: %v", err)
+	}
+	if v := i.Id(); v != "2" {
+		t.Fatalf("importUser resolved email to id %s. should have been 2", v)
+	}
+
+	m.EXPECT().SearchUsers(Any(), Any()).Return(nil, zendesk.Page{}, nil)
+
+	i.SetId("missing@example.com")
+	if err := importUser(context.Background(), i, m); err == nil {
+		t.Fatalf("importUser should return an error for unknown email")
+	}
+}
@@ -0,0 +1,484 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// go-zendesk only reads views and leaves out their conditions and
+// execution, so views are requested with BaseAPI.
+type view struct {
+	ID          int64          `json:"id,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Active      bool           `json:"active"`
+	Position    int64          `json:"position,omitempty"`
+	Restriction interface{}    `json:"restriction"`
+	Conditions  viewConditions `json:"conditions"`
+	Execution   viewExecution  `json:"execution"`
+}
+
+type viewConditions struct {
+	All []viewCondition `json:"all"`
+	Any []viewCondition `json:"any"`
+}
+
+type viewCondition struct {
+	Field    string      `json:"field"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// viewExecution is how the API returns the output settings of a view.
+// Columns and fields to group or sort by are ids of custom fields or names
+// of system fields.
+type viewExecution struct {
+	Columns    []viewColumn `json:"columns"`
+	GroupBy    interface{}  `json:"group_by"`
+	GroupOrder string       `json:"group_order"`
+	SortBy     interface{}  `json:"sort_by"`
+	SortOrder  string       `json:"sort_order"`
+}
+
+type viewColumn struct {
+	ID    interface{} `json:"id"`
+	Title string      `json:"title"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/business-rules/views/
+func resourceZendeskView() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a view resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createView(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readView(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return updateView(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteView(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "The API url of this view.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"title": {
+				Description: "The title of the view.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the view.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the view is active.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"position": {
+				Description: "The position of the view.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"restriction": restrictionSchema("Who may access this view. Available to all agents if not set."),
+			// Both the "all" and "any" parameter are optional, but at least one of them must be supplied
+			"all": triggerConditionSchema("Logical AND. Tickets must fulfill all of the conditions to be considered matching."),
+			"any": triggerConditionSchema("Logical OR. Tickets may satisfy any of the conditions to be considered matching."),
+			"columns": {
+				Description: "The ticket fields to display, in order. System fields are referred to by name, e.g. `subject`, and custom fields by id. Zendesk falls back to its default columns when not set, so removing this keeps the current columns.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Computed: true,
+			},
+			"group_by": {
+				Description: "The ticket field to group tickets by. Tickets are not grouped if not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"group_order": {
+				Description:  "The order of groups. Possible values are `asc` or `desc`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+			},
+			"sort_by": {
+				Description: "The ticket field to sort tickets by. Zendesk falls back to its default sort field when not set, so removing this keeps the current field.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"sort_order": {
+				Description:  "The order of tickets. Possible values are `asc` or `desc`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalView(vw view, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"url":         vw.URL,
+		"title":       vw.Title,
+		"description": vw.Description,
+		"active":      vw.Active,
+		"position":    vw.Position,
+		"group_by":    viewFieldString(vw.Execution.GroupBy),
+		"group_order": vw.Execution.GroupOrder,
+		"sort_by":     viewFieldString(vw.Execution.SortBy),
+		"sort_order":  vw.Execution.SortOrder,
+	}
+
+	restriction, err := marshalRestriction(vw.Restriction)
+	if err != nil {
+		return fmt.Errorf("error decoding view restriction: %s", err)
+	}
+	fields["restriction"] = restriction
+
+	all, err := marshalViewConditions(vw.Conditions.All)
+	if err != nil {
+		return err
+	}
+	fields["all"] = all
+
+	any, err := marshalViewConditions(vw.Conditions.Any)
+	if err != nil {
+		return err
+	}
+	fields["any"] = any
+
+	columns := make([]string, 0, len(vw.Execution.Columns))
+	for _, c := range vw.Execution.Columns {
+		columns = append(columns, viewFieldString(c.ID))
+	}
+	fields["columns"] = columns
+
+	return setSchemaFields(d, fields)
+}
+
+func marshalViewConditions(conditions []viewCondition) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	for _, c := range conditions {
+		// If the condition value is a list, marshal it to a string
+		value := viewFieldString(c.Value)
+		if v, ok := c.Value.([]interface{}); ok {
+			tmp, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding view condition value: %s", err)
+			}
+			value = string(tmp)
+		}
+
+		result = append(result, map[string]interface{}{
+			"field":    c.Field,
+			"operator": c.Operator,
+			"value":    value,
+		})
+	}
+	return result, nil
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalView(d identifiableGetterSetter) (view, error) {
+	vw := view{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return vw, fmt.Errorf("could not parse view id %s: %v", v, err)
+		}
+		vw.ID = id
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		vw.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		vw.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("active"); ok {
+		vw.Active = v.(bool)
+	}
+
+	if v, ok := d.GetOk("restriction"); ok {
+		r, err := unmarshalRestriction(v.([]interface{}))
+		if err != nil {
+			return vw, fmt.Errorf("could not parse restriction for view %v: %v", vw.Title, err)
+		}
+		vw.Restriction = r
+	}
+
+	if v, ok := d.GetOk("all"); ok {
+		conditions, err := unmarshalViewConditions(v.(*schema.Set).List())
+		if err != nil {
+			return vw, fmt.Errorf("could not parse 'all' conditions for view %v: %v", vw.Title, err)
+		}
+		vw.Conditions.All = conditions
+	}
+
+	if v, ok := d.GetOk("any"); ok {
+		conditions, err := unmarshalViewConditions(v.(*schema.Set).List())
+		if err != nil {
+			return vw, fmt.Errorf("could not parse 'any' conditions for view %v: %v", vw.Title, err)
+		}
+		vw.Conditions.Any = conditions
+	}
+
+	if v, ok := d.GetOk("columns"); ok {
+		for _, c := range v.([]interface{}) {
+			vw.Execution.Columns = append(vw.Execution.Columns, viewColumn{ID: c.(string)})
+		}
+	}
+
+	if v, ok := d.GetOk("group_by"); ok {
+		vw.Execution.GroupBy = v.(string)
+	}
+
+	if v, ok := d.GetOk("group_order"); ok {
+		vw.Execution.GroupOrder = v.(string)
+	}
+
+	if v, ok := d.GetOk("sort_by"); ok {
+		vw.Execution.SortBy = v.(string)
+	}
+
+	if v, ok := d.GetOk("sort_order"); ok {
+		vw.Execution.SortOrder = v.(string)
+	}
+
+	return vw, nil
+}
+
+func unmarshalViewConditions(list []interface{}) ([]viewCondition, error) {
+	conditions := []viewCondition{}
+	for _, c := range list {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected condition %v", c)
+		}
+
+		// If the condition value is a list, unmarshal it
+		var value interface{}
+		if strings.HasPrefix(condition["value"].(string), "[") {
+			err := json.Unmarshal([]byte(condition["value"].(string)), &value)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshalling view condition value: %s", err)
+			}
+		} else {
+			value = condition["value"]
+		}
+
+		conditions = append(conditions, viewCondition{
+			Field:    condition["field"].(string),
+			Operator: condition["operator"].(string),
+			Value:    value,
+		})
+	}
+	return conditions, nil
+}
+
+// viewPayload converts the view to the request format, in which
+// conditions are given as all/any and execution settings as output
+func viewPayload(vw view) map[string]interface{} {
+	output := map[string]interface{}{}
+
+	if len(vw.Execution.Columns) > 0 {
+		columns := make([]interface{}, 0, len(vw.Execution.Columns))
+		for _, c := range vw.Execution.Columns {
+			columns = append(columns, viewFieldID(c.ID))
+		}
+		output["columns"] = columns
+	}
+	// group_by is always sent so that removing it stops grouping
+	output["group_by"] = viewFieldID(vw.Execution.GroupBy)
+	if vw.Execution.GroupOrder != "" {
+		output["group_order"] = vw.Execution.GroupOrder
+	}
+	if vw.Execution.SortBy != nil {
+		output["sort_by"] = viewFieldID(vw.Execution.SortBy)
+	}
+	if vw.Execution.SortOrder != "" {
+		output["sort_order"] = vw.Execution.SortOrder
+	}
+
+	return map[string]interface{}{
+		"view": map[string]interface{}{
+			"title":       vw.Title,
+			"description": vw.Description,
+			"active":      vw.Active,
+			"restriction": vw.Restriction,
+			"all":         vw.Conditions.All,
+			"any":         vw.Conditions.Any,
+			"output":      output,
+		},
+	}
+}
+
+// viewFieldString converts a field id or name returned by the API to a string
+func viewFieldString(v interface{}) string {
+	switch f := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return f
+	case float64:
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", f)
+	}
+}
+
+// viewFieldID sends ids of custom fields as numbers
+func viewFieldID(v interface{}) interface{} {
+	if s, ok := v.(string); ok {
+		if id, err := atoi64(s); err == nil {
+			return id
+		}
+	}
+	return v
+}
+
+func createView(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vw, err := unmarshalView(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/views.json", viewPayload(vw))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	vw, err = decodeView(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", vw.ID))
+
+	err = marshalView(vw, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readView(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/views/%d.json", id))
+	if isNotFound(err) {
+		return removeNotFound(d, "View")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	vw, err := decodeView(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalView(vw, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateView(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vw, err := unmarshalView(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Put(ctx, fmt.Sprintf("/views/%d.json", vw.ID), viewPayload(vw))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	vw, err = decodeView(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalView(vw, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteView(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	err = zd.Delete(ctx, fmt.Sprintf("/views/%d.json", id))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func decodeView(body []byte) (view, error) {
+	var result struct {
+		View view `json:"view"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.View, err
+}
@@ -0,0 +1,279 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testViewResponse = `{
+  "view": {
+    "id": 12345,
+    "title": "Unassigned tickets",
+    "description": "Tickets waiting for an agent",
+    "active": true,
+    "position": 3,
+    "restriction": {"type": "Group", "id": 10, "ids": [10]},
+    "conditions": {
+      "all": [{"field": "assignee_id", "operator": "is", "value": null}],
+      "any": [{"field": "group_id", "operator": "is", "value": 10}]
+    },
+    "execution": {
+      "columns": [{"id": "subject", "title": "Subject"}, {"id": 360001234567, "title": "Product"}],
+      "group_by": "status",
+      "group_order": "asc",
+      "sort_by": 360001234567,
+      "sort_order": "desc"
+    }
+  }
+}`
+
+func TestMarshalView(t *testing.T) {
+	v, err := decodeView([]byte(testViewResponse))
+	if err != nil {
+		t.Fatalf("Failed to decode view %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err = marshalView(v, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	cases := map[string]interface{}{
+		"title":       "Unassigned tickets",
+		"description": "Tickets waiting for an agent",
+		"active":      true,
+		"position":    int64(3),
+		"group_by":    "status",
+		"group_order": "asc",
+		"sort_by":     "360001234567",
+		"sort_order":  "desc",
+	}
+	for k, expected := range cases {
+		if v := m.Get(k); v != expected {
+			t.Fatalf("view had incorrect %s value %v. should have been %v", k, v, expected)
+		}
+	}
+
+	columns := m.Get("columns").([]string)
+	if len(columns) != 2 || columns[0] != "subject" || columns[1] != "360001234567" {
+		t.Fatalf("view had incorrect columns %v", columns)
+	}
+
+	all := m.Get("all").([]map[string]interface{})
+	if len(all) != 1 || all[0]["value"] != "" {
+		t.Fatalf("view had incorrect all conditions %v", all)
+	}
+
+	any := m.Get("any").([]map[string]interface{})
+	if len(any) != 1 || any[0]["value"] != "10" {
+		t.Fatalf("view had incorrect any conditions %v", any)
+	}
+
+	restriction := m.Get("restriction").([]map[string]interface{})
+	if len(restriction) != 1 || restriction[0]["type"] != "Group" {
+		t.Fatalf("view had incorrect restriction %v", restriction)
+	}
+}
+
+func TestUnmarshalView(t *testing.T) {
+	conditionResource := resourceZendeskView().Schema["all"].Elem.(*schema.Resource)
+	m := &identifiableMapGetterSetter{
+		id: "100",
+		mapGetterSetter: mapGetterSetter{
+			"title":  "Unassigned tickets",
+			"active": true,
+			"all": schema.NewSet(schema.HashResource(conditionResource), []interface{}{
+				map[string]interface{}{
+					"field":    "assignee_id",
+					"operator": "is",
+					"value":    "",
+				},
+			}),
+			"columns":    []interface{}{"subject", "360001234567"},
+			"sort_by":    "360001234567",
+			"sort_order": "desc",
+		},
+	}
+
+	v, err := unmarshalView(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if v.ID != 100 {
+		t.Fatalf("view had id value %v. should have been 100", v.ID)
+	}
+	if len(v.Conditions.All) != 1 || v.Conditions.All[0].Field != "assignee_id" {
+		t.Fatalf("view had incorrect all conditions %v", v.Conditions.All)
+	}
+
+	output := viewPayload(v)["view"].(map[string]interface{})["output"].(map[string]interface{})
+	columns := output["columns"].([]interface{})
+	if len(columns) != 2 || columns[0] != "subject" || columns[1] != int64(360001234567) {
+		t.Fatalf("view payload had incorrect columns %v", columns)
+	}
+	if output["sort_by"] != int64(360001234567) {
+		t.Fatalf("view payload had incorrect sort_by %v", output["sort_by"])
+	}
+	if v, ok := output["group_by"]; !ok || v != nil {
+		t.Fatalf("view payload should send a null group_by. got %v", v)
+	}
+}
+
+func TestViewConditionListValue(t *testing.T) {
+	v, err := decodeView([]byte(`{
+  "view": {
+    "id": 12345,
+    "title": "Tagged tickets",
+    "conditions": {
+      "all": [{"field": "current_tags", "operator": "includes", "value": ["vip", "urgent"]}],
+      "any": []
+    }
+  }
+}`))
+	if err != nil {
+		t.Fatalf("Failed to decode view %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err = marshalView(v, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	all := m.Get("all").([]map[string]interface{})
+	if len(all) != 1 || all[0]["value"] != `["vip","urgent"]` {
+		t.Fatalf("view had incorrect all conditions %v", all)
+	}
+
+	conditions, err := unmarshalViewConditions([]interface{}{all[0]})
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+	value, ok := conditions[0].Value.([]interface{})
+	if !ok || len(value) != 2 || value[0] != "vip" || value[1] != "urgent" {
+		t.Fatalf("view condition had incorrect value %v", conditions[0].Value)
+	}
+}
+
+func TestCreateView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/views.json"), gomock.Any()).Return([]byte(testViewResponse), nil)
+	if diags := createView(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createView returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("createView did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/views/12345.json")).Return([]byte(testViewResponse), nil)
+	if diags := readView(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readView returned an error: %v", diags)
+	}
+
+	if v := i.Get("title"); v != "Unassigned tickets" {
+		t.Fatalf("readView did not set title. title was %v", v)
+	}
+}
+
+func TestReadViewNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/views/12345.json")).Return(nil, newNotFoundError())
+	diags := readView(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readView returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readView should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readView did not remove resource from state. Id was %s", v)
+	}
+}
+
+func TestUpdateView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "12345",
+		mapGetterSetter: mapGetterSetter{
+			"title":    "Unassigned tickets",
+			"group_by": "status",
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/views/12345.json"), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			View struct {
+				Title  string                 `json:"title"`
+				Output map[string]interface{} `json:"output"`
+			} `json:"view"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if req.View.Output["group_by"] != "status" {
+			t.Fatalf("update request did not send output.group_by: %s", b)
+		}
+
+		return []byte(testViewResponse), nil
+	})
+	if diags := updateView(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateView returned an error %v", diags)
+	}
+}
+
+func TestDeleteView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("1234")
+
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/views/1234.json")).Return(nil)
+	diags := deleteView(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from resource delete: %v", diags)
+	}
+}
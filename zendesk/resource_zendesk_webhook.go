@@ -0,0 +1,455 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// webhook adds custom headers, which go-zendesk does not support yet.
+// Description is always sent so that it can be cleared.
+type webhook struct {
+	client.Webhook
+	Description   string            `json:"description"`
+	CustomHeaders map[string]string `json:"custom_headers,omitempty"`
+}
+
+// Credentials of each authentication type. Zendesk never returns secrets,
+// so they are kept from the configuration.
+var webhookAuthenticationSecrets = map[string][]string{
+	"basic_auth":   {"password"},
+	"bearer_token": {"token"},
+	"api_key":      {"value"},
+}
+
+// https://developer.zendesk.com/api-reference/webhooks/webhooks-api/webhooks/
+func resourceZendeskWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a webhook resource. Webhooks replace HTTP targets.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return createWebhook(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return readWebhook(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			if d.HasChange("rotate_signing_secret") {
+				if diags := rotateWebhookSigningSecret(ctx, d, zd); diags.HasError() {
+					return diags
+				}
+			}
+			return updateWebhook(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			zd := meta.(*client.Client)
+			return deleteWebhook(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.ComputedIf("signing_secret", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("rotate_signing_secret")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the webhook.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the webhook.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"endpoint": {
+				Description:  "The destination URL that the webhook notifies when Zendesk events occur.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"http_method": {
+				Description: "HTTP method used for the webhook's requests. Possible values are `GET`, `POST`, `PUT`, `PATCH` or `DELETE`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "POST",
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET",
+					"POST",
+					"PUT",
+					"PATCH",
+					"DELETE",
+				}, false),
+			},
+			"request_format": {
+				Description: "The format of the data that the webhook will send. Possible values are `json`, `xml` or `form_encoded`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "json",
+				ValidateFunc: validation.StringInSlice([]string{
+					"json",
+					"xml",
+					"form_encoded",
+				}, false),
+			},
+			"status": {
+				Description:  "Current status of the webhook. Possible values are `active` or `inactive`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+			"subscriptions": {
+				Description: "Event subscriptions for the webhook. Use `conditional_ticket_events` to connect the webhook to triggers or automations.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"authentication": {
+				Description: "Adds authentication to the webhook's requests.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "Type of authentication. Possible values are `basic_auth`, `bearer_token` or `api_key`.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"basic_auth",
+								"bearer_token",
+								"api_key",
+							}, false),
+						},
+						"add_position": {
+							Description: "Where the credentials are added to the request.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "header",
+						},
+						"username": {
+							Description: "Username for `basic_auth`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"password": {
+							Description: "Password for `basic_auth`. Zendesk does not return it, so changes made outside of Terraform are not detected.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"token": {
+							Description: "Token for `bearer_token`. Zendesk does not return it, so changes made outside of Terraform are not detected.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"name": {
+							Description: "Header name for `api_key`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"value": {
+							Description: "Key for `api_key`. Zendesk does not return it, so changes made outside of Terraform are not detected.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"signing_secret": {
+				Description: "The secret Zendesk signs the webhook's requests with, so that receivers can verify them.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"signing_secret_algorithm": {
+				Description: "The algorithm used to sign the webhook's requests.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rotate_signing_secret": {
+				Description: "Any change to this value resets the signing secret, e.g. a timestamp of the last rotation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"custom_headers": {
+				Description: "Custom headers to deliver additional non-credential info to the destination.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Marshal the zendesk client object to the terraform schema
+func marshalWebhook(hook webhook, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":           hook.Name,
+		"description":    hook.Description,
+		"endpoint":       hook.Endpoint,
+		"http_method":    hook.HTTPMethod,
+		"request_format": hook.RequestFormat,
+		"status":         hook.Status,
+		"subscriptions":  hook.Subscriptions,
+		"custom_headers": hook.CustomHeaders,
+	}
+
+	if secret := hook.SigningSecret; secret != nil {
+		fields["signing_secret"] = secret.Secret
+		fields["signing_secret_algorithm"] = secret.Algorithm
+	}
+
+	var authentication []map[string]interface{}
+	if auth := hook.Authentication; auth != nil {
+		a := map[string]interface{}{
+			"type":         auth.Type,
+			"add_position": auth.AddPosition,
+		}
+
+		if data, ok := auth.Data.(map[string]interface{}); ok {
+			for _, k := range []string{"username", "name"} {
+				if v, ok := data[k].(string); ok {
+					a[k] = v
+				}
+			}
+		}
+
+		if v, ok := d.GetOk("authentication"); ok {
+			if prev, ok := v.([]interface{}); ok && len(prev) > 0 {
+				a = mergeWebhookSecrets(a, prev[0])
+			}
+		}
+
+		authentication = append(authentication, a)
+	}
+	fields["authentication"] = authentication
+
+	return setSchemaFields(d, fields)
+}
+
+// mergeWebhookSecrets copies the credentials of the authentication in state,
+// unless the authentication type changed
+func mergeWebhookSecrets(auth map[string]interface{}, state interface{}) map[string]interface{} {
+	prev, ok := state.(map[string]interface{})
+	if !ok || prev["type"] != auth["type"] {
+		return auth
+	}
+
+	for _, k := range webhookAuthenticationSecrets[auth["type"].(string)] {
+		auth[k] = prev[k]
+	}
+
+	return auth
+}
+
+// Unmarshal the terraform schema to the Zendesk client object
+func unmarshalWebhook(d identifiableGetterSetter) (webhook, error) {
+	hook := webhook{}
+	hook.ID = d.Id()
+
+	if v, ok := d.GetOk("name"); ok {
+		hook.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		hook.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("endpoint"); ok {
+		hook.Endpoint = v.(string)
+	}
+
+	if v, ok := d.GetOk("http_method"); ok {
+		hook.HTTPMethod = v.(string)
+	}
+
+	if v, ok := d.GetOk("request_format"); ok {
+		hook.RequestFormat = v.(string)
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		hook.Status = v.(string)
+	}
+
+	if v, ok := d.GetOk("subscriptions"); ok {
+		for _, s := range v.(*schema.Set).List() {
+			hook.Subscriptions = append(hook.Subscriptions, s.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("custom_headers"); ok {
+		hook.CustomHeaders = map[string]string{}
+		for name, value := range v.(map[string]interface{}) {
+			hook.CustomHeaders[name] = value.(string)
+		}
+	}
+
+	if v, ok := d.GetOk("authentication"); ok {
+		auth, ok := v.([]interface{})[0].(map[string]interface{})
+		if !ok {
+			return hook, fmt.Errorf("could not parse authentication for webhook %v", hook.Name)
+		}
+
+		var data map[string]interface{}
+		switch auth["type"] {
+		case "basic_auth":
+			data = map[string]interface{}{
+				"username": auth["username"],
+				"password": auth["password"],
+			}
+		case "bearer_token":
+			data = map[string]interface{}{
+				"token": auth["token"],
+			}
+		case "api_key":
+			data = map[string]interface{}{
+				"name":  auth["name"],
+				"value": auth["value"],
+			}
+		default:
+			return hook, fmt.Errorf("unsupported authentication type %v for webhook %v", auth["type"], hook.Name)
+		}
+
+		hook.Authentication = &client.WebhookAuthentication{
+			Type:        auth["type"].(string),
+			Data:        data,
+			AddPosition: auth["add_position"].(string),
+		}
+	}
+
+	return hook, nil
+}
+
+func createWebhook(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	hook, err := unmarshalWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	body, err := zd.Post(ctx, "/webhooks", map[string]interface{}{"webhook": hook})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	hook, err = decodeWebhook(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(hook.ID)
+
+	// The signing secret is only returned by its own endpoint
+	return readWebhook(ctx, d, zd)
+}
+
+func readWebhook(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/webhooks/%s", d.Id()))
+	if isNotFound(err) {
+		return removeNotFound(d, "Webhook")
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	hook, err := decodeWebhook(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hook.SigningSecret, err = fetchWebhookSigningSecret(ctx, zd, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = marshalWebhook(hook, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateWebhook(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	hook, err := unmarshalWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Actual API request
+	_, err = zd.Put(ctx, fmt.Sprintf("/webhooks/%s", d.Id()), map[string]interface{}{"webhook": hook})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// Zendesk responds to updates with no content
+	return readWebhook(ctx, d, zd)
+}
+
+func deleteWebhook(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Actual API request
+	err := zd.Delete(ctx, fmt.Sprintf("/webhooks/%s", d.Id()))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+// rotateWebhookSigningSecret resets the signing secret. The new secret is
+// stored by the following read.
+func rotateWebhookSigningSecret(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Actual API request
+	_, err := zd.Post(ctx, fmt.Sprintf("/webhooks/%s/signing_secret", d.Id()), map[string]interface{}{})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func fetchWebhookSigningSecret(ctx context.Context, zd client.BaseAPI, id string) (*client.WebhookSigningSecret, error) {
+	var result struct {
+		SigningSecret *client.WebhookSigningSecret `json:"signing_secret"`
+	}
+
+	body, err := zd.Get(ctx, fmt.Sprintf("/webhooks/%s/signing_secret", id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result.SigningSecret, err
+}
+
+func decodeWebhook(body []byte) (webhook, error) {
+	var result struct {
+		Webhook webhook `json:"webhook"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	return result.Webhook, err
+}
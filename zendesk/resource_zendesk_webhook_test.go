@@ -0,0 +1,294 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const testWebhookSigningSecretResponse = `{"signing_secret":{"algorithm":"SHA256","secret":"dGhpcyBpcyBhIHNlY3JldA=="}}`
+
+const testWebhookResponse = `{
+  "webhook": {
+    "id": "01GDXYD7ZTWYP542BA8MDDTE36",
+    "name": "Notify backend",
+    "endpoint": "https://example.com/zendesk",
+    "http_method": "POST",
+    "request_format": "json",
+    "status": "active",
+    "subscriptions": ["conditional_ticket_events"],
+    "custom_headers": {"X-Environment": "production"},
+    "authentication": {"type": "basic_auth", "add_position": "header", "data": {"username": "zendesk"}}
+  }
+}`
+
+func TestMarshalWebhook(t *testing.T) {
+	hook, err := decodeWebhook([]byte(testWebhookResponse))
+	if err != nil {
+		t.Fatalf("Failed to decode webhook %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"authentication": []interface{}{
+				map[string]interface{}{
+					"type":     "basic_auth",
+					"username": "zendesk",
+					"password": "secret",
+				},
+			},
+		},
+	}
+
+	err = marshalWebhook(hook, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	cases := map[string]interface{}{
+		"name":           "Notify backend",
+		"endpoint":       "https://example.com/zendesk",
+		"http_method":    "POST",
+		"request_format": "json",
+		"status":         "active",
+	}
+	for k, expected := range cases {
+		if v := m.Get(k); v != expected {
+			t.Fatalf("webhook had incorrect %s value %v. should have been %v", k, v, expected)
+		}
+	}
+
+	if v := m.Get("custom_headers").(map[string]string); v["X-Environment"] != "production" {
+		t.Fatalf("webhook had incorrect custom_headers %v", v)
+	}
+
+	auth := m.Get("authentication").([]map[string]interface{})
+	if len(auth) != 1 || auth[0]["username"] != "zendesk" {
+		t.Fatalf("webhook had incorrect authentication %v", auth)
+	}
+	if auth[0]["password"] != "secret" {
+		t.Fatalf("webhook did not keep password from state. got %v", auth[0]["password"])
+	}
+}
+
+func TestMarshalWebhookAuthenticationTypeChanged(t *testing.T) {
+	hook, err := decodeWebhook([]byte(testWebhookResponse))
+	if err != nil {
+		t.Fatalf("Failed to decode webhook %v", err)
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"authentication": []interface{}{
+				map[string]interface{}{
+					"type":  "bearer_token",
+					"token": "secret",
+				},
+			},
+		},
+	}
+
+	err = marshalWebhook(hook, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	auth := m.Get("authentication").([]map[string]interface{})
+	if v, ok := auth[0]["token"]; ok {
+		t.Fatalf("webhook kept token of another authentication type: %v", v)
+	}
+}
+
+func TestUnmarshalWebhook(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "01GDXYD7ZTWYP542BA8MDDTE36",
+		mapGetterSetter: mapGetterSetter{
+			"name":           "Notify backend",
+			"endpoint":       "https://example.com/zendesk",
+			"http_method":    "POST",
+			"request_format": "json",
+			"status":         "active",
+			"subscriptions":  schema.NewSet(schema.HashString, []interface{}{"conditional_ticket_events"}),
+			"custom_headers": map[string]interface{}{"X-Environment": "production"},
+			"authentication": []interface{}{
+				map[string]interface{}{
+					"type":         "api_key",
+					"add_position": "header",
+					"name":         "X-Api-Key",
+					"value":        "secret",
+				},
+			},
+		},
+	}
+
+	hook, err := unmarshalWebhook(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if hook.ID != "01GDXYD7ZTWYP542BA8MDDTE36" {
+		t.Fatalf("webhook had id value %v", hook.ID)
+	}
+	if len(hook.Subscriptions) != 1 || hook.Subscriptions[0] != "conditional_ticket_events" {
+		t.Fatalf("webhook had subscriptions %v", hook.Subscriptions)
+	}
+	if hook.CustomHeaders["X-Environment"] != "production" {
+		t.Fatalf("webhook had custom headers %v", hook.CustomHeaders)
+	}
+
+	data := hook.Authentication.Data.(map[string]interface{})
+	if hook.Authentication.Type != "api_key" || data["name"] != "X-Api-Key" || data["value"] != "secret" {
+		t.Fatalf("webhook had incorrect authentication %v", hook.Authentication)
+	}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/webhooks"), gomock.Any()).Return([]byte(testWebhookResponse), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36")).Return([]byte(testWebhookResponse), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36/signing_secret")).Return([]byte(testWebhookSigningSecretResponse), nil)
+	if diags := createWebhook(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createWebhook returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "01GDXYD7ZTWYP542BA8MDDTE36" {
+		t.Fatalf("createWebhook did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("01GDXYD7ZTWYP542BA8MDDTE36")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36")).Return([]byte(testWebhookResponse), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36/signing_secret")).Return([]byte(testWebhookSigningSecretResponse), nil)
+	if diags := readWebhook(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readWebhook returned an error: %v", diags)
+	}
+
+	if v := i.Get("signing_secret"); v != "dGhpcyBpcyBhIHNlY3JldA==" {
+		t.Fatalf("readWebhook did not set signing_secret. got %v", v)
+	}
+	if v := i.Get("signing_secret_algorithm"); v != "SHA256" {
+		t.Fatalf("readWebhook did not set signing_secret_algorithm. got %v", v)
+	}
+}
+
+func TestReadWebhookNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("01GDXYD7ZTWYP542BA8MDDTE36")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36")).Return(nil, newNotFoundError())
+	diags := readWebhook(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readWebhook returned an error for a deleted resource: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readWebhook should return a single warning. got %v", diags)
+	}
+	if v := i.Id(); v != "" {
+		t.Fatalf("readWebhook did not remove resource from state. Id was %s", v)
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "01GDXYD7ZTWYP542BA8MDDTE36",
+		mapGetterSetter: mapGetterSetter{
+			"name":     "Notify backend",
+			"endpoint": "https://example.com/zendesk",
+			"authentication": []interface{}{
+				map[string]interface{}{
+					"type":         "basic_auth",
+					"add_position": "header",
+					"username":     "zendesk",
+					"password":     "secret",
+				},
+			},
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36"), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+
+		var req struct {
+			Webhook struct {
+				Description    *string `json:"description"`
+				Authentication struct {
+					Data map[string]string `json:"data"`
+				} `json:"authentication"`
+			} `json:"webhook"`
+		}
+		if err := json.Unmarshal(b, &req); err != nil {
+			t.Fatalf("could not unmarshal request: %v", err)
+		}
+		if req.Webhook.Authentication.Data["password"] != "secret" {
+			t.Fatalf("update request did not send password: %s", b)
+		}
+		if req.Webhook.Description == nil || *req.Webhook.Description != "" {
+			t.Fatalf("update request did not clear description: %s", b)
+		}
+
+		return nil, nil
+	})
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36")).Return([]byte(testWebhookResponse), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36/signing_secret")).Return([]byte(testWebhookSigningSecretResponse), nil)
+	if diags := updateWebhook(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateWebhook returned an error %v", diags)
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("01GDXYD7ZTWYP542BA8MDDTE36")
+
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36")).Return(nil)
+	diags := deleteWebhook(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from resource delete: %v", diags)
+	}
+}
+
+func TestRotateWebhookSigningSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("01GDXYD7ZTWYP542BA8MDDTE36")
+
+	c.EXPECT().Post(gomock.Any(), gomock.Eq("/webhooks/01GDXYD7ZTWYP542BA8MDDTE36/signing_secret"), gomock.Any()).Return([]byte(testWebhookSigningSecretResponse), nil)
+	diags := rotateWebhookSigningSecret(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from signing secret rotation: %v", diags)
+	}
+}
@@ -0,0 +1,103 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// accessRestriction limits who may use a macro or a view. Zendesk takes
+// ids for groups but a single id for a user.
+type accessRestriction struct {
+	Type string  `json:"type"`
+	ID   int64   `json:"id,omitempty"`
+	IDs  []int64 `json:"ids,omitempty"`
+}
+
+func restrictionSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Description: desc,
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Description:  "Restrict access to `Group` or `User`.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"Group", "User"}, false),
+				},
+				"ids": {
+					Description: "The ids of the groups, or a single id of the user, access is restricted to.",
+					Type:        schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeInt,
+					},
+					Required: true,
+					MinItems: 1,
+				},
+			},
+		},
+		Optional: true,
+	}
+}
+
+// marshalRestriction converts the restriction object returned by the API,
+// which has both id and ids for groups, to the restriction block
+func marshalRestriction(v interface{}) ([]map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r accessRestriction
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+
+	ids := r.IDs
+	if len(ids) == 0 && r.ID != 0 {
+		ids = []int64{r.ID}
+	}
+
+	return []map[string]interface{}{
+		{
+			"type": r.Type,
+			"ids":  ids,
+		},
+	}, nil
+}
+
+// unmarshalRestriction returns nil when the block is not set,
+// so that the restriction is lifted by sending null
+func unmarshalRestriction(restrictions []interface{}) (interface{}, error) {
+	if len(restrictions) == 0 {
+		return nil, nil
+	}
+
+	restriction, ok := restrictions[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected restriction %v", restrictions[0])
+	}
+
+	r := accessRestriction{Type: restriction["type"].(string)}
+	for _, id := range restriction["ids"].(*schema.Set).List() {
+		r.IDs = append(r.IDs, int64(id.(int)))
+	}
+
+	// Restriction to a user takes a single id
+	if r.Type == "User" {
+		if len(r.IDs) != 1 {
+			return nil, fmt.Errorf("restriction of type User takes exactly one id, got %d", len(r.IDs))
+		}
+		r.ID, r.IDs = r.IDs[0], nil
+	}
+
+	return r, nil
+}
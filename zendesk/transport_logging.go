@@ -0,0 +1,174 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "[REDACTED]"
+
+// JSON fields whose values never appear in logs
+var redactedFields = map[string]bool{
+	"password": true,
+	"token":    true,
+
+	// Webhook credentials, e.g. the value of an api_key, and signing secrets
+	"authentication": true,
+	"secret":         true,
+}
+
+// Headers whose values never appear in logs
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+}
+
+// loggingTransport logs API requests through tflog so that they show up
+// with TF_LOG=DEBUG. Headers and bodies are only logged at TRACE.
+type loggingTransport struct {
+	base http.RoundTripper
+
+	// Bodies are only buffered and redacted when they will be logged
+	trace bool
+}
+
+func newLoggingTransport(base http.RoundTripper) *loggingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &loggingTransport{base: base, trace: traceLogEnabled()}
+}
+
+// traceLogEnabled reports whether the provider logs at TRACE, following
+// the precedence of TF_LOG_PROVIDER over TF_LOG used by Terraform
+func traceLogEnabled() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+
+	// TF_LOG=JSON logs at TRACE in JSON format
+	return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+	if req.URL.RawQuery != "" {
+		fields["http_query"] = req.URL.RawQuery
+	}
+
+	if t.trace {
+		trace := map[string]interface{}{
+			"http_request_headers": redactHeaders(req.Header),
+		}
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				b, _ := io.ReadAll(body)
+				body.Close()
+				trace["http_request_body"] = redactBody(b)
+			}
+		}
+		tflog.Trace(ctx, "Sending Zendesk API request", fields, trace)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Zendesk API request failed", fields)
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	for field, header := range map[string]string{
+		"rate_limit":           "X-Rate-Limit",
+		"rate_limit_remaining": "X-Rate-Limit-Remaining",
+		"retry_after":          "Retry-After",
+	} {
+		if v := resp.Header.Get(header); v != "" {
+			fields[field] = v
+		}
+	}
+	tflog.Debug(ctx, "Received Zendesk API response", fields)
+
+	if !t.trace {
+		return resp, nil
+	}
+
+	trace := map[string]interface{}{
+		"http_response_headers": redactHeaders(resp.Header),
+	}
+	if resp.Body != nil {
+		b, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(b))
+		if err != nil {
+			return resp, err
+		}
+		trace["http_response_body"] = redactBody(b)
+	}
+	tflog.Trace(ctx, "Received Zendesk API response body", fields, trace)
+
+	return resp, nil
+}
+
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			out[k] = redactedValue
+			continue
+		}
+		out[k] = strings.Join(v, ", ")
+	}
+
+	return out
+}
+
+// redactBody masks sensitive fields of JSON bodies. go-zendesk only sends
+// JSON, so other bodies are error pages from Zendesk or proxies and kept as is.
+func redactBody(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return string(b)
+	}
+
+	out, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(out)
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if redactedFields[k] {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redactJSON(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactJSON(e)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,167 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG", "TRACE")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit", "700")
+		w.Header().Set("X-Rate-Limit-Remaining", "699")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"user":{"id":1,"token":"response-secret"}}`))
+	}))
+	defer ts.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/api/v2/users.json",
+		strings.NewReader(`{"user":{"name":"foo","password":"request-secret"}}`))
+	req.SetBasicAuth("john.doe@example.com/token", "header-secret")
+
+	resp, err := (&http.Client{Transport: newLoggingTransport(http.DefaultTransport)}).Do(req)
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "response-secret") {
+		t.Fatalf("response body was not passed through. got %s", body)
+	}
+
+	logs := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 log entries. got %d: %v", len(entries), entries)
+	}
+
+	debug := entries[1]
+	expected := map[string]interface{}{
+		"@level":               "debug",
+		"http_method":          http.MethodPost,
+		"http_path":            "/api/v2/users.json",
+		"http_status":          float64(http.StatusCreated),
+		"rate_limit":           "700",
+		"rate_limit_remaining": "699",
+	}
+	for k, v := range expected {
+		if debug[k] != v {
+			t.Fatalf("log field %s was %v. should have been %v", k, debug[k], v)
+		}
+	}
+	if _, ok := debug["http_duration_ms"]; !ok {
+		t.Fatalf("log entry did not have duration")
+	}
+
+	for _, secret := range []string{"request-secret", "response-secret", "header-secret"} {
+		if strings.Contains(logs, secret) {
+			t.Fatalf("log output contains secret %s: %s", secret, logs)
+		}
+	}
+	if !strings.Contains(logs, redactedValue) {
+		t.Fatalf("log output does not contain redacted values: %s", logs)
+	}
+}
+
+func TestLoggingTransportWithoutTrace(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "DEBUG")
+	t.Setenv("TF_LOG", "TRACE")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"user":{"id":1}}`))
+	}))
+	defer ts.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/api/v2/users/1.json", nil)
+	resp, err := (&http.Client{Transport: newLoggingTransport(http.DefaultTransport)}).Do(req)
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+	if len(entries) != 1 || entries[0]["@level"] != "debug" {
+		t.Fatalf("expected only the debug log entry. got %v", entries)
+	}
+}
+
+func TestHTTPClientLogsUserAgent(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG", "TRACE")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c, err := newHTTPClient(Config{
+		UserAgent:         "terraform-provider-zendesk/test terraform/1.5.0",
+		RequestsPerMinute: defaultRequestsPerMinute,
+	})
+	if err != nil {
+		t.Fatalf("newHTTPClient returned an error: %v", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/api/v2/users/1.json", nil)
+	req.Header.Set("User-Agent", "Zendesk SDK for Go/v0.16.0")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+
+	headers, _ := entries[0]["http_request_headers"].(map[string]interface{})
+	if v := headers["User-Agent"]; v != "terraform-provider-zendesk/test terraform/1.5.0" {
+		t.Fatalf("logged User-Agent was %v. should have been the provider User-Agent", v)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{`{"password":"x","name":"foo"}`, `{"name":"foo","password":"[REDACTED]"}`},
+		{`[{"nested":{"token":"x"}}]`, `[{"nested":{"token":"[REDACTED]"}}]`},
+		{`{"webhook":{"authentication":{"data":{"value":"x"}}}}`, `{"webhook":{"authentication":"[REDACTED]"}}`},
+		{`{"signing_secret":{"algorithm":"SHA256","secret":"x"}}`, `{"signing_secret":{"algorithm":"SHA256","secret":"[REDACTED]"}}`},
+		{`<html>Bad Gateway</html>`, `<html>Bad Gateway</html>`},
+		{``, ``},
+	}
+
+	for _, c := range cases {
+		if v := redactBody([]byte(c.in)); v != c.expected {
+			t.Fatalf("redactBody(%s) was %s. should have been %s", c.in, v, c.expected)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package zendesk
+
+import (
+	"net/http"
+	"strconv"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Lowest per-account limit among Zendesk Support plans (Team)
+	// https://developer.zendesk.com/api-reference/introduction/rate-limits/
+	defaultRequestsPerMinute = 200
+
+	rateLimitBurst = 10
+)
+
+// rateLimitTransport throttles outgoing requests with a token bucket so that
+// resources applied in parallel stay under the account quota. When adaptive
+// is true the rate follows the X-Rate-Limit header reported by Zendesk,
+// which reflects the limit of the account plan.
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	limiter  *rate.Limiter
+	adaptive bool
+}
+
+func newRateLimitTransport(base http.RoundTripper, requestsPerMinute int, adaptive bool) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{
+		base:     base,
+		limiter:  rate.NewLimiter(perMinute(requestsPerMinute), rateLimitBurst),
+		adaptive: adaptive,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if t.adaptive {
+		if limit, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit")); err == nil && limit > 0 {
+			if l := perMinute(limit); l != t.limiter.Limit() {
+				t.limiter.SetLimit(l)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+func perMinute(n int) rate.Limit {
+	return rate.Limit(float64(n) / 60)
+}
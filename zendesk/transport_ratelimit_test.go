@@ -0,0 +1,77 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransportThrottles(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// 600 req/min = 10 req/s, so requests beyond the burst wait ~100ms each
+	c := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 600, false)}
+
+	start := time.Now()
+	for i := 0; i < rateLimitBurst+2; i++ {
+		resp, err := c.Get(ts.URL)
+		if err != nil {
+			t.Fatalf("request returned an error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("requests beyond burst were not throttled. took %v", elapsed)
+	}
+}
+
+func TestRateLimitTransportAdaptsToAccountLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit", "700")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	cases := []struct {
+		adaptive bool
+		expected float64
+	}{
+		{true, 700},
+		{false, defaultRequestsPerMinute},
+	}
+
+	for _, c := range cases {
+		tr := newRateLimitTransport(http.DefaultTransport, defaultRequestsPerMinute, c.adaptive)
+		resp, err := (&http.Client{Transport: tr}).Get(ts.URL)
+		if err != nil {
+			t.Fatalf("request returned an error: %v", err)
+		}
+		resp.Body.Close()
+
+		if v := float64(tr.limiter.Limit()) * 60; int(v+0.5) != int(c.expected) {
+			t.Fatalf("adaptive=%v limit was %v req/min. should have been %v", c.adaptive, v, c.expected)
+		}
+	}
+}
+
+func TestRateLimitTransportStopsOnContextCancel(t *testing.T) {
+	tr := newRateLimitTransport(http.DefaultTransport, 1, false)
+	// drain the burst
+	for i := 0; i < rateLimitBurst; i++ {
+		tr.limiter.Allow()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1", nil)
+	if _, err := tr.RoundTrip(req); err == nil {
+		t.Fatalf("request should have failed after context was cancelled")
+	}
+}
@@ -0,0 +1,156 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 1
+	defaultMaxBackoff = 30
+)
+
+// retryTransport retries requests rejected by rate limiting or failed with
+// server errors. Throttled (429) requests were never processed by Zendesk,
+// so they are retried for every method. Server errors and network failures
+// are only retried for idempotent methods.
+// https://developer.zendesk.com/api-reference/introduction/rate-limits/
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// now is replaceable for testing
+	now func() time.Time
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, minBackoff, maxBackoff time.Duration) *retryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		now:        time.Now,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		// Body can't be replayed, e.g. streamed attachment uploads
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. Server hints
+// take precedence over exponential backoff, but neither waits longer than
+// maxBackoff so that a bogus Retry-After can't stall an apply.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := t.retryAfter(resp.Header); ok {
+			if wait > t.maxBackoff {
+				wait = t.maxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := t.minBackoff
+	for i := 0; i < attempt && wait < t.maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > t.maxBackoff {
+		wait = t.maxBackoff
+	}
+
+	return wait
+}
+
+// retryAfter parses Retry-After, which is either delay-seconds or HTTP-date.
+// When it is absent but the rate limit is exhausted, wait until the limit resets.
+func (t *retryTransport) retryAfter(h http.Header) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+			return time.Duration(sec) * time.Second, true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			if wait := date.Sub(t.now()); wait > 0 {
+				return wait, true
+			}
+			return 0, true
+		}
+	}
+
+	if h.Get("X-Rate-Limit-Remaining") == "0" {
+		if sec, err := strconv.Atoi(h.Get("Ratelimit-Reset")); err == nil && sec >= 0 {
+			return time.Duration(sec) * time.Second, true
+		}
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,162 @@
+package zendesk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, maxRetries, time.Millisecond, 10*time.Millisecond),
+	}
+}
+
+func TestRetryTransportRetriesTooManyRequests(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"group":{}}` {
+			t.Errorf("request body was not replayed. got %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	resp, err := newTestRetryClient(3).Post(ts.URL, "application/json", strings.NewReader(`{"group":{}}`))
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status code was %d. should have been %d", resp.StatusCode, http.StatusCreated)
+	}
+	if calls != 3 {
+		t.Fatalf("server was called %d times. should have been 3", calls)
+	}
+}
+
+func TestRetryTransportGivesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	resp, err := newTestRetryClient(2).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("status code was %d. should have been %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+	if calls != 3 {
+		t.Fatalf("server was called %d times. should have been 3", calls)
+	}
+}
+
+func TestRetryTransportDoesNotRetryNonIdempotentServerError(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	resp, err := newTestRetryClient(3).Post(ts.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if calls != 1 {
+		t.Fatalf("server was called %d times. should have been 1", calls)
+	}
+}
+
+func TestRetryTransportDoesNotRetryClientError(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer ts.Close()
+
+	resp, err := newTestRetryClient(3).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("request returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if calls != 1 {
+		t.Fatalf("server was called %d times. should have been 1", calls)
+	}
+}
+
+func TestRetryTransportStopsOnContextCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	start := time.Now()
+	c := &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, time.Minute),
+	}
+	_, err := c.Do(req)
+	if err == nil {
+		t.Fatalf("request should have failed after context was cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("request waited %v after context was cancelled", elapsed)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tr := newRetryTransport(nil, 5, time.Second, 10*time.Second)
+	tr.now = func() time.Time { return now }
+
+	cases := []struct {
+		attempt  int
+		header   http.Header
+		expected time.Duration
+	}{
+		{0, http.Header{}, time.Second},
+		{1, http.Header{}, 2 * time.Second},
+		{3, http.Header{}, 8 * time.Second},
+		{10, http.Header{}, 10 * time.Second},
+		{0, http.Header{"Retry-After": {"4"}}, 4 * time.Second},
+		{0, http.Header{"Retry-After": {"86400"}}, 10 * time.Second},
+		{0, http.Header{"Retry-After": {now.Add(time.Hour).Format(http.TimeFormat)}}, 10 * time.Second},
+		{0, http.Header{"Retry-After": {now.Add(5 * time.Second).Format(http.TimeFormat)}}, 5 * time.Second},
+		{0, http.Header{"X-Rate-Limit-Remaining": {"0"}, "Ratelimit-Reset": {"7"}}, 7 * time.Second},
+		{0, http.Header{"X-Rate-Limit-Remaining": {"10"}, "Ratelimit-Reset": {"7"}}, time.Second},
+	}
+
+	for _, c := range cases {
+		resp := &http.Response{Header: c.header}
+		if v := tr.backoff(c.attempt, resp); v != c.expected {
+			t.Fatalf("backoff(%d, %v) was %v. should have been %v", c.attempt, c.header, v, c.expected)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package zendesk
+
+import (
+	"net/http"
+)
+
+// userAgentTransport overrides the User-Agent set by go-zendesk so that
+// Zendesk support can identify requests sent by this provider.
+type userAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func newUserAgentTransport(base http.RoundTripper, userAgent string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if userAgent == "" {
+		return base
+	}
+
+	return &userAgentTransport{
+		base:      base,
+		userAgent: userAgent,
+	}
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+
+	return t.base.RoundTrip(req)
+}
@@ -1,11 +1,16 @@
 package zendesk
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
 type getter interface {
@@ -105,3 +110,55 @@ func setSchemaFields(d setter, m map[string]interface{}) error {
 func atoi64(anum string) (int64, error) {
 	return strconv.ParseInt(anum, 10, 64)
 }
+
+// parseCompositeID parses IDs such as "group_id:user_id" used for import
+func parseCompositeID(id string, parts ...string) ([]int64, error) {
+	format := strings.Join(parts, ":")
+
+	values := strings.Split(id, ":")
+	if len(values) != len(parts) {
+		return nil, fmt.Errorf("unexpected format of ID %q, expected %s", id, format)
+	}
+
+	ids := make([]int64, len(values))
+	for i, v := range values {
+		n, err := atoi64(v)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s of ID %q, expected %s: %v", parts[i], id, format, err)
+		}
+		ids[i] = n
+	}
+
+	return ids, nil
+}
+
+// isNotFound reports whether err is a Zendesk API error with 404 status
+func isNotFound(err error) bool {
+	var zdErr client.Error
+	if errors.As(err, &zdErr) {
+		return zdErr.Status() == http.StatusNotFound
+	}
+
+	return false
+}
+
+// removeNotFound clears the resource ID so that Terraform drops it from state
+// and plans a re-create, instead of failing the whole plan.
+func removeNotFound(d identifiable, kind string) diag.Diagnostics {
+	id := d.Id()
+	d.SetId("")
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s %s not found", kind, id),
+			Detail:   fmt.Sprintf("%s %s no longer exists in Zendesk and has been removed from state.", kind, id),
+		},
+	}
+}
+
+// suppressUnsetDefault ignores default = false for resources where Zendesk
+// only lets another resource take over the default, so it can't be unset.
+func suppressUnsetDefault(k, old, new string, d *schema.ResourceData) bool {
+	return new == "false"
+}
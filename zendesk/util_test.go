@@ -1,11 +1,15 @@
 package zendesk
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
 )
 
 func TestIsValidFile(t *testing.T) {
@@ -52,3 +56,41 @@ func concatExampleConfig(t *testing.T, configs ...string) string {
 
 	return builder.String()
 }
+
+func newNotFoundError() error {
+	return zendesk.NewError(nil, &http.Response{StatusCode: http.StatusNotFound})
+}
+
+func TestIsNotFound(t *testing.T) {
+	if !isNotFound(newNotFoundError()) {
+		t.Fatalf("isNotFound did not detect 404 error")
+	}
+
+	if isNotFound(zendesk.NewError(nil, &http.Response{StatusCode: http.StatusUnprocessableEntity})) {
+		t.Fatalf("isNotFound detected 422 error as not found")
+	}
+
+	if isNotFound(fmt.Errorf("wrapped: %w", errors.New("plain error"))) {
+		t.Fatalf("isNotFound detected non-API error as not found")
+	}
+
+	if !isNotFound(fmt.Errorf("wrapped: %w", newNotFoundError())) {
+		t.Fatalf("isNotFound did not detect wrapped 404 error")
+	}
+}
+
+func TestParseCompositeID(t *testing.T) {
+	ids, err := parseCompositeID("12:34", "group_id", "user_id")
+	if err != nil {
+		t.Fatalf("parseCompositeID returned an error: %v", err)
+	}
+	if ids[0] != 12 || ids[1] != 34 {
+		t.Fatalf("parseCompositeID returned %v. should have been [12 34]", ids)
+	}
+
+	for _, id := range []string{"12", "12:34:56", "12:abc", ""} {
+		if _, err := parseCompositeID(id, "group_id", "user_id"); err == nil {
+			t.Fatalf("parseCompositeID did not return an error for %q", id)
+		}
+	}
+}